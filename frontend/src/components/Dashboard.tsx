@@ -6,7 +6,7 @@ import { useAuth } from '../contexts/AuthContext';
 import { useAccessLogger } from '../hooks/useAccessLogger';
 
 const LOAN_CASHFLOW_QUERY = gql`
-  query GetLoanCashFlow($loanCode: [String!]!, $endDate: String!) {
+  query GetLoanCashFlow($loanCode: [String!]!, $endDate: Date) {
     loanCashFlow {
       byLoanCode(loanCode: $loanCode, endDate: $endDate) {
         loanCode
@@ -160,7 +160,7 @@ export default function Dashboard() {
     fetchData({
       variables: {
         loanCode: [],
-        endDate: endDate,
+        endDate: endDate || null,
       },
     });
   };
@@ -214,7 +214,7 @@ export default function Dashboard() {
         fetchData({
           variables: {
             loanCode: [],
-            endDate: endDate,
+            endDate: endDate || null,
           },
         });
       }
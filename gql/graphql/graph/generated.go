@@ -39,34 +39,106 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	LoanCashFlow() LoanCashFlowResolver
 	LoanCashFlows() LoanCashFlowsResolver
 	Mutation() MutationResolver
+	Properties() PropertiesResolver
+	Property() PropertyResolver
 	Query() QueryResolver
+	SsotReportsAdministratorConfiguration() SsotReportsAdministratorConfigurationResolver
 }
 
 type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
-	ACLMutationResult struct {
+	ACLAuditChange struct {
+		After  func(childComplexity int) int
+		Before func(childComplexity int) int
+		Path   func(childComplexity int) int
+	}
+
+	ACLAuditEntry struct {
+		Actor       func(childComplexity int) int
+		Changes     func(childComplexity int) int
+		EntryID     func(childComplexity int) int
+		Operation   func(childComplexity int) int
+		PrincipalID func(childComplexity int) int
+		Timestamp   func(childComplexity int) int
+		Version     func(childComplexity int) int
+	}
+
+	ACLExport struct {
+		Content     func(childComplexity int) int
+		ContentType func(childComplexity int) int
+		Filename    func(childComplexity int) int
+		Format      func(childComplexity int) int
+		RowCount    func(childComplexity int) int
+	}
+
+	ACLImportResult struct {
+		Applied func(childComplexity int) int
 		Message func(childComplexity int) int
-		Record  func(childComplexity int) int
+		Rows    func(childComplexity int) int
 		Success func(childComplexity int) int
 	}
 
+	ACLImportRowResult struct {
+		Line        func(childComplexity int) int
+		Message     func(childComplexity int) int
+		PrincipalID func(childComplexity int) int
+		Status      func(childComplexity int) int
+	}
+
+	ACLMutationResult struct {
+		Conflict func(childComplexity int) int
+		Message  func(childComplexity int) int
+		Record   func(childComplexity int) int
+		Success  func(childComplexity int) int
+	}
+
 	ACLRecord struct {
-		FieldFilters func(childComplexity int) int
-		Groups       func(childComplexity int) int
-		Permissions  func(childComplexity int) int
-		PrincipalID  func(childComplexity int) int
-		UpdatedAt    func(childComplexity int) int
+		FieldFilters    func(childComplexity int) int
+		GroupValidity   func(childComplexity int) int
+		Groups          func(childComplexity int) int
+		MergeStrategies func(childComplexity int) int
+		Permissions     func(childComplexity int) int
+		PrincipalID     func(childComplexity int) int
+		UpdatedAt       func(childComplexity int) int
+		Version         func(childComplexity int) int
+	}
+
+	ACLRecordVersion struct {
+		Actor       func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		Operation   func(childComplexity int) int
+		PrincipalID func(childComplexity int) int
+		Record      func(childComplexity int) int
+		Version     func(childComplexity int) int
+	}
+
+	ExpiringGrant struct {
+		Kind        func(childComplexity int) int
+		Name        func(childComplexity int) int
+		PrincipalID func(childComplexity int) int
+		ValidUntil  func(childComplexity int) int
 	}
 
 	FieldFilter struct {
+		AllOf       func(childComplexity int) int
+		AnyOf       func(childComplexity int) int
 		ExcludeList func(childComplexity int) int
 		Field       func(childComplexity int) int
 		FilterType  func(childComplexity int) int
 		IncludeList func(childComplexity int) int
+		ValidFrom   func(childComplexity int) int
+		ValidUntil  func(childComplexity int) int
+	}
+
+	GroupGrant struct {
+		Group      func(childComplexity int) int
+		ValidFrom  func(childComplexity int) int
+		ValidUntil func(childComplexity int) int
 	}
 
 	LoanCashFlow struct {
@@ -87,6 +159,7 @@ type ComplexityRoot struct {
 		LeverageInterest                 func(childComplexity int) int
 		LoanCode                         func(childComplexity int) int
 		LoanDesc                         func(childComplexity int) int
+		LoanInfo                         func(childComplexity int) int
 		MaxHmy                           func(childComplexity int) int
 		PaymentNumber                    func(childComplexity int) int
 		PostDate                         func(childComplexity int) int
@@ -96,8 +169,57 @@ type ComplexityRoot struct {
 		Status                           func(childComplexity int) int
 	}
 
+	LoanCashFlowAggregateGroup struct {
+		Count   func(childComplexity int) int
+		Key     func(childComplexity int) int
+		Metrics func(childComplexity int) int
+	}
+
+	LoanCashFlowConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	LoanCashFlowEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	LoanCashFlowGroupKey struct {
+		Field func(childComplexity int) int
+		Value func(childComplexity int) int
+	}
+
+	LoanCashFlowMetricValue struct {
+		Field    func(childComplexity int) int
+		Function func(childComplexity int) int
+		Value    func(childComplexity int) int
+	}
+
 	LoanCashFlows struct {
-		ByLoanCode func(childComplexity int, loanCode []*string, endDate *string) int
+		ByLoanCode           func(childComplexity int, loanCode []*string, endDate *model.Date, startDate *model.Date, glPeriodStart *model.Date, glPeriodEnd *model.Date, order *model.SortOrder, latest *int32, filter *model.RowFilterInput, sort []*model.LoanCashFlowSort) int
+		ByLoanCodeConnection func(childComplexity int, loanCode []*string, endDate *model.Date, startDate *model.Date, glPeriodStart *model.Date, glPeriodEnd *model.Date, order *model.SortOrder, filter *model.RowFilterInput, first *int32, after *string) int
+	}
+
+	LoanInfo struct {
+		Borrower         func(childComplexity int) int
+		CommitmentAmount func(childComplexity int) int
+		Fund             func(childComplexity int) int
+		InterestRate     func(childComplexity int) int
+		Lender           func(childComplexity int) int
+		LoanCode         func(childComplexity int) int
+		LoanName         func(childComplexity int) int
+		LoanStatus       func(childComplexity int) int
+		LoanType         func(childComplexity int) int
+		MaturityDate     func(childComplexity int) int
+		OriginationDate  func(childComplexity int) int
+		PropertyCode     func(childComplexity int) int
+		PropertyName     func(childComplexity int) int
+	}
+
+	MergeStrategy struct {
+		Field    func(childComplexity int) int
+		Strategy func(childComplexity int) int
 	}
 
 	Mutation struct {
@@ -105,27 +227,61 @@ type ComplexityRoot struct {
 		AddUserACL     func(childComplexity int, input model.AddUserACLInput) int
 		DeleteGroupACL func(childComplexity int, groupName string) int
 		DeleteUserACL  func(childComplexity int, email string) int
+		ImportACL      func(childComplexity int, file graphql.Upload, format *model.ACLFileFormat, mode model.ACLImportMode, dryRun bool) int
+		RollbackACL    func(childComplexity int, principalID string, version int32) int
 		UpdateGroupACL func(childComplexity int, input model.UpdateGroupACLInput) int
 		UpdateUserACL  func(childComplexity int, input model.UpdateUserACLInput) int
 	}
 
+	PageInfo struct {
+		EndCursor       func(childComplexity int) int
+		HasNextPage     func(childComplexity int) int
+		HasPreviousPage func(childComplexity int) int
+		StartCursor     func(childComplexity int) int
+	}
+
 	Permission struct {
-		Action func(childComplexity int) int
-		Table  func(childComplexity int) int
+		Action     func(childComplexity int) int
+		Column     func(childComplexity int) int
+		Table      func(childComplexity int) int
+		ValidFrom  func(childComplexity int) int
+		ValidUntil func(childComplexity int) int
+	}
+
+	Properties struct {
+		ByPropertyCode func(childComplexity int, propertyCode []string) int
+	}
+
+	Property struct {
+		CashFlows    func(childComplexity int, startDate *model.Date, endDate *model.Date, glPeriodStart *model.Date, glPeriodEnd *model.Date, order *model.SortOrder, filter *model.RowFilterInput, sort []*model.LoanCashFlowSort) int
+		Loans        func(childComplexity int) int
+		PropertyCode func(childComplexity int) int
+		PropertyName func(childComplexity int) int
 	}
 
 	Query struct {
 		LoanCashFlow                          func(childComplexity int) int
+		LoanCashFlowAggregate                 func(childComplexity int, groupBy []string, metrics []*model.LoanCashFlowMetricInput, filter *model.LoanCashFlowAggregateFilter) int
+		LoanInfo                              func(childComplexity int, loanCode []string, filter *model.RowFilterInput) int
+		Property                              func(childComplexity int) int
 		SsotReportsAdministratorConfiguration func(childComplexity int) int
 	}
 
 	SsotReportsAdministratorConfiguration struct {
-		ListACLRecords func(childComplexity int) int
+		ACLAuditLog      func(childComplexity int, filter *model.ACLAuditFilter, limit int32) int
+		ACLRecordHistory func(childComplexity int, principalID string) int
+		ExpiringGrants   func(childComplexity int, withinDays int32) int
+		ExportACL        func(childComplexity int, format model.ACLFileFormat) int
+		ListACLRecords   func(childComplexity int) int
 	}
 }
 
+type LoanCashFlowResolver interface {
+	LoanInfo(ctx context.Context, obj *model.LoanCashFlow) (*model.LoanInfo, error)
+}
 type LoanCashFlowsResolver interface {
-	ByLoanCode(ctx context.Context, obj *model.LoanCashFlows, loanCode []*string, endDate *string) ([]*model.LoanCashFlow, error)
+	ByLoanCode(ctx context.Context, obj *model.LoanCashFlows, loanCode []*string, endDate *model.Date, startDate *model.Date, glPeriodStart *model.Date, glPeriodEnd *model.Date, order *model.SortOrder, latest *int32, filter *model.RowFilterInput, sort []*model.LoanCashFlowSort) ([]*model.LoanCashFlow, error)
+	ByLoanCodeConnection(ctx context.Context, obj *model.LoanCashFlows, loanCode []*string, endDate *model.Date, startDate *model.Date, glPeriodStart *model.Date, glPeriodEnd *model.Date, order *model.SortOrder, filter *model.RowFilterInput, first *int32, after *string) (*model.LoanCashFlowConnection, error)
 }
 type MutationResolver interface {
 	AddUserACL(ctx context.Context, input model.AddUserACLInput) (*model.ACLMutationResult, error)
@@ -134,11 +290,29 @@ type MutationResolver interface {
 	UpdateGroupACL(ctx context.Context, input model.UpdateGroupACLInput) (*model.ACLMutationResult, error)
 	DeleteUserACL(ctx context.Context, email string) (*model.ACLMutationResult, error)
 	DeleteGroupACL(ctx context.Context, groupName string) (*model.ACLMutationResult, error)
+	RollbackACL(ctx context.Context, principalID string, version int32) (*model.ACLMutationResult, error)
+	ImportACL(ctx context.Context, file graphql.Upload, format *model.ACLFileFormat, mode model.ACLImportMode, dryRun bool) (*model.ACLImportResult, error)
+}
+type PropertiesResolver interface {
+	ByPropertyCode(ctx context.Context, obj *model.Properties, propertyCode []string) ([]*model.Property, error)
+}
+type PropertyResolver interface {
+	Loans(ctx context.Context, obj *model.Property) ([]*model.LoanInfo, error)
+	CashFlows(ctx context.Context, obj *model.Property, startDate *model.Date, endDate *model.Date, glPeriodStart *model.Date, glPeriodEnd *model.Date, order *model.SortOrder, filter *model.RowFilterInput, sort []*model.LoanCashFlowSort) ([]*model.LoanCashFlow, error)
 }
 type QueryResolver interface {
 	LoanCashFlow(ctx context.Context) (*model.LoanCashFlows, error)
+	LoanCashFlowAggregate(ctx context.Context, groupBy []string, metrics []*model.LoanCashFlowMetricInput, filter *model.LoanCashFlowAggregateFilter) ([]*model.LoanCashFlowAggregateGroup, error)
+	LoanInfo(ctx context.Context, loanCode []string, filter *model.RowFilterInput) ([]*model.LoanInfo, error)
+	Property(ctx context.Context) (*model.Properties, error)
 	SsotReportsAdministratorConfiguration(ctx context.Context) (*model.SsotReportsAdministratorConfiguration, error)
 }
+type SsotReportsAdministratorConfigurationResolver interface {
+	ExpiringGrants(ctx context.Context, obj *model.SsotReportsAdministratorConfiguration, withinDays int32) ([]*model.ExpiringGrant, error)
+	ACLAuditLog(ctx context.Context, obj *model.SsotReportsAdministratorConfiguration, filter *model.ACLAuditFilter, limit int32) ([]*model.ACLAuditEntry, error)
+	ACLRecordHistory(ctx context.Context, obj *model.SsotReportsAdministratorConfiguration, principalID string) ([]*model.ACLRecordVersion, error)
+	ExportACL(ctx context.Context, obj *model.SsotReportsAdministratorConfiguration, format model.ACLFileFormat) (*model.ACLExport, error)
+}
 
 type executableSchema struct {
 	schema     *ast.Schema
@@ -159,6 +333,155 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	_ = ec
 	switch typeName + "." + field {
 
+	case "ACLAuditChange.after":
+		if e.complexity.ACLAuditChange.After == nil {
+			break
+		}
+
+		return e.complexity.ACLAuditChange.After(childComplexity), true
+	case "ACLAuditChange.before":
+		if e.complexity.ACLAuditChange.Before == nil {
+			break
+		}
+
+		return e.complexity.ACLAuditChange.Before(childComplexity), true
+	case "ACLAuditChange.path":
+		if e.complexity.ACLAuditChange.Path == nil {
+			break
+		}
+
+		return e.complexity.ACLAuditChange.Path(childComplexity), true
+
+	case "ACLAuditEntry.actor":
+		if e.complexity.ACLAuditEntry.Actor == nil {
+			break
+		}
+
+		return e.complexity.ACLAuditEntry.Actor(childComplexity), true
+	case "ACLAuditEntry.changes":
+		if e.complexity.ACLAuditEntry.Changes == nil {
+			break
+		}
+
+		return e.complexity.ACLAuditEntry.Changes(childComplexity), true
+	case "ACLAuditEntry.entryID":
+		if e.complexity.ACLAuditEntry.EntryID == nil {
+			break
+		}
+
+		return e.complexity.ACLAuditEntry.EntryID(childComplexity), true
+	case "ACLAuditEntry.operation":
+		if e.complexity.ACLAuditEntry.Operation == nil {
+			break
+		}
+
+		return e.complexity.ACLAuditEntry.Operation(childComplexity), true
+	case "ACLAuditEntry.principalID":
+		if e.complexity.ACLAuditEntry.PrincipalID == nil {
+			break
+		}
+
+		return e.complexity.ACLAuditEntry.PrincipalID(childComplexity), true
+	case "ACLAuditEntry.timestamp":
+		if e.complexity.ACLAuditEntry.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.ACLAuditEntry.Timestamp(childComplexity), true
+	case "ACLAuditEntry.version":
+		if e.complexity.ACLAuditEntry.Version == nil {
+			break
+		}
+
+		return e.complexity.ACLAuditEntry.Version(childComplexity), true
+
+	case "ACLExport.content":
+		if e.complexity.ACLExport.Content == nil {
+			break
+		}
+
+		return e.complexity.ACLExport.Content(childComplexity), true
+	case "ACLExport.contentType":
+		if e.complexity.ACLExport.ContentType == nil {
+			break
+		}
+
+		return e.complexity.ACLExport.ContentType(childComplexity), true
+	case "ACLExport.filename":
+		if e.complexity.ACLExport.Filename == nil {
+			break
+		}
+
+		return e.complexity.ACLExport.Filename(childComplexity), true
+	case "ACLExport.format":
+		if e.complexity.ACLExport.Format == nil {
+			break
+		}
+
+		return e.complexity.ACLExport.Format(childComplexity), true
+	case "ACLExport.rowCount":
+		if e.complexity.ACLExport.RowCount == nil {
+			break
+		}
+
+		return e.complexity.ACLExport.RowCount(childComplexity), true
+
+	case "ACLImportResult.applied":
+		if e.complexity.ACLImportResult.Applied == nil {
+			break
+		}
+
+		return e.complexity.ACLImportResult.Applied(childComplexity), true
+	case "ACLImportResult.message":
+		if e.complexity.ACLImportResult.Message == nil {
+			break
+		}
+
+		return e.complexity.ACLImportResult.Message(childComplexity), true
+	case "ACLImportResult.rows":
+		if e.complexity.ACLImportResult.Rows == nil {
+			break
+		}
+
+		return e.complexity.ACLImportResult.Rows(childComplexity), true
+	case "ACLImportResult.success":
+		if e.complexity.ACLImportResult.Success == nil {
+			break
+		}
+
+		return e.complexity.ACLImportResult.Success(childComplexity), true
+
+	case "ACLImportRowResult.line":
+		if e.complexity.ACLImportRowResult.Line == nil {
+			break
+		}
+
+		return e.complexity.ACLImportRowResult.Line(childComplexity), true
+	case "ACLImportRowResult.message":
+		if e.complexity.ACLImportRowResult.Message == nil {
+			break
+		}
+
+		return e.complexity.ACLImportRowResult.Message(childComplexity), true
+	case "ACLImportRowResult.principalID":
+		if e.complexity.ACLImportRowResult.PrincipalID == nil {
+			break
+		}
+
+		return e.complexity.ACLImportRowResult.PrincipalID(childComplexity), true
+	case "ACLImportRowResult.status":
+		if e.complexity.ACLImportRowResult.Status == nil {
+			break
+		}
+
+		return e.complexity.ACLImportRowResult.Status(childComplexity), true
+
+	case "ACLMutationResult.conflict":
+		if e.complexity.ACLMutationResult.Conflict == nil {
+			break
+		}
+
+		return e.complexity.ACLMutationResult.Conflict(childComplexity), true
 	case "ACLMutationResult.message":
 		if e.complexity.ACLMutationResult.Message == nil {
 			break
@@ -184,12 +507,24 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.ACLRecord.FieldFilters(childComplexity), true
+	case "ACLRecord.groupValidity":
+		if e.complexity.ACLRecord.GroupValidity == nil {
+			break
+		}
+
+		return e.complexity.ACLRecord.GroupValidity(childComplexity), true
 	case "ACLRecord.groups":
 		if e.complexity.ACLRecord.Groups == nil {
 			break
 		}
 
 		return e.complexity.ACLRecord.Groups(childComplexity), true
+	case "ACLRecord.mergeStrategies":
+		if e.complexity.ACLRecord.MergeStrategies == nil {
+			break
+		}
+
+		return e.complexity.ACLRecord.MergeStrategies(childComplexity), true
 	case "ACLRecord.permissions":
 		if e.complexity.ACLRecord.Permissions == nil {
 			break
@@ -208,7 +543,87 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.ACLRecord.UpdatedAt(childComplexity), true
+	case "ACLRecord.version":
+		if e.complexity.ACLRecord.Version == nil {
+			break
+		}
+
+		return e.complexity.ACLRecord.Version(childComplexity), true
 
+	case "ACLRecordVersion.actor":
+		if e.complexity.ACLRecordVersion.Actor == nil {
+			break
+		}
+
+		return e.complexity.ACLRecordVersion.Actor(childComplexity), true
+	case "ACLRecordVersion.createdAt":
+		if e.complexity.ACLRecordVersion.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ACLRecordVersion.CreatedAt(childComplexity), true
+	case "ACLRecordVersion.operation":
+		if e.complexity.ACLRecordVersion.Operation == nil {
+			break
+		}
+
+		return e.complexity.ACLRecordVersion.Operation(childComplexity), true
+	case "ACLRecordVersion.principalID":
+		if e.complexity.ACLRecordVersion.PrincipalID == nil {
+			break
+		}
+
+		return e.complexity.ACLRecordVersion.PrincipalID(childComplexity), true
+	case "ACLRecordVersion.record":
+		if e.complexity.ACLRecordVersion.Record == nil {
+			break
+		}
+
+		return e.complexity.ACLRecordVersion.Record(childComplexity), true
+	case "ACLRecordVersion.version":
+		if e.complexity.ACLRecordVersion.Version == nil {
+			break
+		}
+
+		return e.complexity.ACLRecordVersion.Version(childComplexity), true
+
+	case "ExpiringGrant.kind":
+		if e.complexity.ExpiringGrant.Kind == nil {
+			break
+		}
+
+		return e.complexity.ExpiringGrant.Kind(childComplexity), true
+	case "ExpiringGrant.name":
+		if e.complexity.ExpiringGrant.Name == nil {
+			break
+		}
+
+		return e.complexity.ExpiringGrant.Name(childComplexity), true
+	case "ExpiringGrant.principalID":
+		if e.complexity.ExpiringGrant.PrincipalID == nil {
+			break
+		}
+
+		return e.complexity.ExpiringGrant.PrincipalID(childComplexity), true
+	case "ExpiringGrant.validUntil":
+		if e.complexity.ExpiringGrant.ValidUntil == nil {
+			break
+		}
+
+		return e.complexity.ExpiringGrant.ValidUntil(childComplexity), true
+
+	case "FieldFilter.allOf":
+		if e.complexity.FieldFilter.AllOf == nil {
+			break
+		}
+
+		return e.complexity.FieldFilter.AllOf(childComplexity), true
+	case "FieldFilter.anyOf":
+		if e.complexity.FieldFilter.AnyOf == nil {
+			break
+		}
+
+		return e.complexity.FieldFilter.AnyOf(childComplexity), true
 	case "FieldFilter.excludeList":
 		if e.complexity.FieldFilter.ExcludeList == nil {
 			break
@@ -233,6 +648,37 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.FieldFilter.IncludeList(childComplexity), true
+	case "FieldFilter.validFrom":
+		if e.complexity.FieldFilter.ValidFrom == nil {
+			break
+		}
+
+		return e.complexity.FieldFilter.ValidFrom(childComplexity), true
+	case "FieldFilter.validUntil":
+		if e.complexity.FieldFilter.ValidUntil == nil {
+			break
+		}
+
+		return e.complexity.FieldFilter.ValidUntil(childComplexity), true
+
+	case "GroupGrant.group":
+		if e.complexity.GroupGrant.Group == nil {
+			break
+		}
+
+		return e.complexity.GroupGrant.Group(childComplexity), true
+	case "GroupGrant.validFrom":
+		if e.complexity.GroupGrant.ValidFrom == nil {
+			break
+		}
+
+		return e.complexity.GroupGrant.ValidFrom(childComplexity), true
+	case "GroupGrant.validUntil":
+		if e.complexity.GroupGrant.ValidUntil == nil {
+			break
+		}
+
+		return e.complexity.GroupGrant.ValidUntil(childComplexity), true
 
 	case "LoanCashFlow.accrualEndDate":
 		if e.complexity.LoanCashFlow.AccrualEndDate == nil {
@@ -336,6 +782,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.LoanCashFlow.LoanDesc(childComplexity), true
+	case "LoanCashFlow.loanInfo":
+		if e.complexity.LoanCashFlow.LoanInfo == nil {
+			break
+		}
+
+		return e.complexity.LoanCashFlow.LoanInfo(childComplexity), true
 	case "LoanCashFlow.maxHmy":
 		if e.complexity.LoanCashFlow.MaxHmy == nil {
 			break
@@ -379,113 +831,472 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.LoanCashFlow.Status(childComplexity), true
 
-	case "LoanCashFlows.byLoanCode":
-		if e.complexity.LoanCashFlows.ByLoanCode == nil {
+	case "LoanCashFlowAggregateGroup.count":
+		if e.complexity.LoanCashFlowAggregateGroup.Count == nil {
 			break
 		}
 
-		args, err := ec.field_LoanCashFlows_byLoanCode_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.LoanCashFlowAggregateGroup.Count(childComplexity), true
+	case "LoanCashFlowAggregateGroup.key":
+		if e.complexity.LoanCashFlowAggregateGroup.Key == nil {
+			break
+		}
+
+		return e.complexity.LoanCashFlowAggregateGroup.Key(childComplexity), true
+	case "LoanCashFlowAggregateGroup.metrics":
+		if e.complexity.LoanCashFlowAggregateGroup.Metrics == nil {
+			break
 		}
 
-		return e.complexity.LoanCashFlows.ByLoanCode(childComplexity, args["loanCode"].([]*string), args["endDate"].(*string)), true
+		return e.complexity.LoanCashFlowAggregateGroup.Metrics(childComplexity), true
 
-	case "Mutation.addGroupACL":
-		if e.complexity.Mutation.AddGroupACL == nil {
+	case "LoanCashFlowConnection.edges":
+		if e.complexity.LoanCashFlowConnection.Edges == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addGroupACL_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.LoanCashFlowConnection.Edges(childComplexity), true
+	case "LoanCashFlowConnection.pageInfo":
+		if e.complexity.LoanCashFlowConnection.PageInfo == nil {
+			break
 		}
 
-		return e.complexity.Mutation.AddGroupACL(childComplexity, args["input"].(model.AddGroupACLInput)), true
-	case "Mutation.addUserACL":
-		if e.complexity.Mutation.AddUserACL == nil {
+		return e.complexity.LoanCashFlowConnection.PageInfo(childComplexity), true
+
+	case "LoanCashFlowEdge.cursor":
+		if e.complexity.LoanCashFlowEdge.Cursor == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addUserACL_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.LoanCashFlowEdge.Cursor(childComplexity), true
+	case "LoanCashFlowEdge.node":
+		if e.complexity.LoanCashFlowEdge.Node == nil {
+			break
 		}
 
-		return e.complexity.Mutation.AddUserACL(childComplexity, args["input"].(model.AddUserACLInput)), true
-	case "Mutation.deleteGroupACL":
-		if e.complexity.Mutation.DeleteGroupACL == nil {
+		return e.complexity.LoanCashFlowEdge.Node(childComplexity), true
+
+	case "LoanCashFlowGroupKey.field":
+		if e.complexity.LoanCashFlowGroupKey.Field == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteGroupACL_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.LoanCashFlowGroupKey.Field(childComplexity), true
+	case "LoanCashFlowGroupKey.value":
+		if e.complexity.LoanCashFlowGroupKey.Value == nil {
+			break
 		}
 
-		return e.complexity.Mutation.DeleteGroupACL(childComplexity, args["groupName"].(string)), true
-	case "Mutation.deleteUserACL":
-		if e.complexity.Mutation.DeleteUserACL == nil {
+		return e.complexity.LoanCashFlowGroupKey.Value(childComplexity), true
+
+	case "LoanCashFlowMetricValue.field":
+		if e.complexity.LoanCashFlowMetricValue.Field == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteUserACL_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.LoanCashFlowMetricValue.Field(childComplexity), true
+	case "LoanCashFlowMetricValue.function":
+		if e.complexity.LoanCashFlowMetricValue.Function == nil {
+			break
 		}
 
-		return e.complexity.Mutation.DeleteUserACL(childComplexity, args["email"].(string)), true
-	case "Mutation.updateGroupACL":
-		if e.complexity.Mutation.UpdateGroupACL == nil {
+		return e.complexity.LoanCashFlowMetricValue.Function(childComplexity), true
+	case "LoanCashFlowMetricValue.value":
+		if e.complexity.LoanCashFlowMetricValue.Value == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updateGroupACL_args(ctx, rawArgs)
+		return e.complexity.LoanCashFlowMetricValue.Value(childComplexity), true
+
+	case "LoanCashFlows.byLoanCode":
+		if e.complexity.LoanCashFlows.ByLoanCode == nil {
+			break
+		}
+
+		args, err := ec.field_LoanCashFlows_byLoanCode_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.UpdateGroupACL(childComplexity, args["input"].(model.UpdateGroupACLInput)), true
-	case "Mutation.updateUserACL":
-		if e.complexity.Mutation.UpdateUserACL == nil {
+		return e.complexity.LoanCashFlows.ByLoanCode(childComplexity, args["loanCode"].([]*string), args["endDate"].(*model.Date), args["startDate"].(*model.Date), args["glPeriodStart"].(*model.Date), args["glPeriodEnd"].(*model.Date), args["order"].(*model.SortOrder), args["latest"].(*int32), args["filter"].(*model.RowFilterInput), args["sort"].([]*model.LoanCashFlowSort)), true
+	case "LoanCashFlows.byLoanCodeConnection":
+		if e.complexity.LoanCashFlows.ByLoanCodeConnection == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updateUserACL_args(ctx, rawArgs)
+		args, err := ec.field_LoanCashFlows_byLoanCodeConnection_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.UpdateUserACL(childComplexity, args["input"].(model.UpdateUserACLInput)), true
+		return e.complexity.LoanCashFlows.ByLoanCodeConnection(childComplexity, args["loanCode"].([]*string), args["endDate"].(*model.Date), args["startDate"].(*model.Date), args["glPeriodStart"].(*model.Date), args["glPeriodEnd"].(*model.Date), args["order"].(*model.SortOrder), args["filter"].(*model.RowFilterInput), args["first"].(*int32), args["after"].(*string)), true
 
-	case "Permission.action":
-		if e.complexity.Permission.Action == nil {
+	case "LoanInfo.borrower":
+		if e.complexity.LoanInfo.Borrower == nil {
 			break
 		}
 
-		return e.complexity.Permission.Action(childComplexity), true
-	case "Permission.table":
-		if e.complexity.Permission.Table == nil {
+		return e.complexity.LoanInfo.Borrower(childComplexity), true
+	case "LoanInfo.commitmentAmount":
+		if e.complexity.LoanInfo.CommitmentAmount == nil {
 			break
 		}
 
-		return e.complexity.Permission.Table(childComplexity), true
-
-	case "Query.loanCashFlow":
-		if e.complexity.Query.LoanCashFlow == nil {
+		return e.complexity.LoanInfo.CommitmentAmount(childComplexity), true
+	case "LoanInfo.fund":
+		if e.complexity.LoanInfo.Fund == nil {
 			break
 		}
 
-		return e.complexity.Query.LoanCashFlow(childComplexity), true
-	case "Query.ssotReportsAdministratorConfiguration":
-		if e.complexity.Query.SsotReportsAdministratorConfiguration == nil {
+		return e.complexity.LoanInfo.Fund(childComplexity), true
+	case "LoanInfo.interestRate":
+		if e.complexity.LoanInfo.InterestRate == nil {
 			break
 		}
 
-		return e.complexity.Query.SsotReportsAdministratorConfiguration(childComplexity), true
-
-	case "SsotReportsAdministratorConfiguration.listACLRecords":
-		if e.complexity.SsotReportsAdministratorConfiguration.ListACLRecords == nil {
+		return e.complexity.LoanInfo.InterestRate(childComplexity), true
+	case "LoanInfo.lender":
+		if e.complexity.LoanInfo.Lender == nil {
+			break
+		}
+
+		return e.complexity.LoanInfo.Lender(childComplexity), true
+	case "LoanInfo.loanCode":
+		if e.complexity.LoanInfo.LoanCode == nil {
+			break
+		}
+
+		return e.complexity.LoanInfo.LoanCode(childComplexity), true
+	case "LoanInfo.loanName":
+		if e.complexity.LoanInfo.LoanName == nil {
+			break
+		}
+
+		return e.complexity.LoanInfo.LoanName(childComplexity), true
+	case "LoanInfo.loanStatus":
+		if e.complexity.LoanInfo.LoanStatus == nil {
+			break
+		}
+
+		return e.complexity.LoanInfo.LoanStatus(childComplexity), true
+	case "LoanInfo.loanType":
+		if e.complexity.LoanInfo.LoanType == nil {
+			break
+		}
+
+		return e.complexity.LoanInfo.LoanType(childComplexity), true
+	case "LoanInfo.maturityDate":
+		if e.complexity.LoanInfo.MaturityDate == nil {
+			break
+		}
+
+		return e.complexity.LoanInfo.MaturityDate(childComplexity), true
+	case "LoanInfo.originationDate":
+		if e.complexity.LoanInfo.OriginationDate == nil {
+			break
+		}
+
+		return e.complexity.LoanInfo.OriginationDate(childComplexity), true
+	case "LoanInfo.propertyCode":
+		if e.complexity.LoanInfo.PropertyCode == nil {
+			break
+		}
+
+		return e.complexity.LoanInfo.PropertyCode(childComplexity), true
+	case "LoanInfo.propertyName":
+		if e.complexity.LoanInfo.PropertyName == nil {
+			break
+		}
+
+		return e.complexity.LoanInfo.PropertyName(childComplexity), true
+
+	case "MergeStrategy.field":
+		if e.complexity.MergeStrategy.Field == nil {
+			break
+		}
+
+		return e.complexity.MergeStrategy.Field(childComplexity), true
+	case "MergeStrategy.strategy":
+		if e.complexity.MergeStrategy.Strategy == nil {
+			break
+		}
+
+		return e.complexity.MergeStrategy.Strategy(childComplexity), true
+
+	case "Mutation.addGroupACL":
+		if e.complexity.Mutation.AddGroupACL == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addGroupACL_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddGroupACL(childComplexity, args["input"].(model.AddGroupACLInput)), true
+	case "Mutation.addUserACL":
+		if e.complexity.Mutation.AddUserACL == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addUserACL_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddUserACL(childComplexity, args["input"].(model.AddUserACLInput)), true
+	case "Mutation.deleteGroupACL":
+		if e.complexity.Mutation.DeleteGroupACL == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteGroupACL_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteGroupACL(childComplexity, args["groupName"].(string)), true
+	case "Mutation.deleteUserACL":
+		if e.complexity.Mutation.DeleteUserACL == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteUserACL_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteUserACL(childComplexity, args["email"].(string)), true
+	case "Mutation.importACL":
+		if e.complexity.Mutation.ImportACL == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_importACL_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ImportACL(childComplexity, args["file"].(graphql.Upload), args["format"].(*model.ACLFileFormat), args["mode"].(model.ACLImportMode), args["dryRun"].(bool)), true
+	case "Mutation.rollbackACL":
+		if e.complexity.Mutation.RollbackACL == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_rollbackACL_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RollbackACL(childComplexity, args["principalID"].(string), args["version"].(int32)), true
+	case "Mutation.updateGroupACL":
+		if e.complexity.Mutation.UpdateGroupACL == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateGroupACL_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateGroupACL(childComplexity, args["input"].(model.UpdateGroupACLInput)), true
+	case "Mutation.updateUserACL":
+		if e.complexity.Mutation.UpdateUserACL == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateUserACL_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateUserACL(childComplexity, args["input"].(model.UpdateUserACLInput)), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
+	case "Permission.action":
+		if e.complexity.Permission.Action == nil {
+			break
+		}
+
+		return e.complexity.Permission.Action(childComplexity), true
+	case "Permission.column":
+		if e.complexity.Permission.Column == nil {
+			break
+		}
+
+		return e.complexity.Permission.Column(childComplexity), true
+	case "Permission.table":
+		if e.complexity.Permission.Table == nil {
+			break
+		}
+
+		return e.complexity.Permission.Table(childComplexity), true
+	case "Permission.validFrom":
+		if e.complexity.Permission.ValidFrom == nil {
+			break
+		}
+
+		return e.complexity.Permission.ValidFrom(childComplexity), true
+	case "Permission.validUntil":
+		if e.complexity.Permission.ValidUntil == nil {
+			break
+		}
+
+		return e.complexity.Permission.ValidUntil(childComplexity), true
+
+	case "Properties.byPropertyCode":
+		if e.complexity.Properties.ByPropertyCode == nil {
+			break
+		}
+
+		args, err := ec.field_Properties_byPropertyCode_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Properties.ByPropertyCode(childComplexity, args["propertyCode"].([]string)), true
+
+	case "Property.cashFlows":
+		if e.complexity.Property.CashFlows == nil {
+			break
+		}
+
+		args, err := ec.field_Property_cashFlows_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Property.CashFlows(childComplexity, args["startDate"].(*model.Date), args["endDate"].(*model.Date), args["glPeriodStart"].(*model.Date), args["glPeriodEnd"].(*model.Date), args["order"].(*model.SortOrder), args["filter"].(*model.RowFilterInput), args["sort"].([]*model.LoanCashFlowSort)), true
+	case "Property.loans":
+		if e.complexity.Property.Loans == nil {
+			break
+		}
+
+		return e.complexity.Property.Loans(childComplexity), true
+	case "Property.propertyCode":
+		if e.complexity.Property.PropertyCode == nil {
+			break
+		}
+
+		return e.complexity.Property.PropertyCode(childComplexity), true
+	case "Property.propertyName":
+		if e.complexity.Property.PropertyName == nil {
+			break
+		}
+
+		return e.complexity.Property.PropertyName(childComplexity), true
+
+	case "Query.loanCashFlow":
+		if e.complexity.Query.LoanCashFlow == nil {
+			break
+		}
+
+		return e.complexity.Query.LoanCashFlow(childComplexity), true
+	case "Query.loanCashFlowAggregate":
+		if e.complexity.Query.LoanCashFlowAggregate == nil {
+			break
+		}
+
+		args, err := ec.field_Query_loanCashFlowAggregate_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.LoanCashFlowAggregate(childComplexity, args["groupBy"].([]string), args["metrics"].([]*model.LoanCashFlowMetricInput), args["filter"].(*model.LoanCashFlowAggregateFilter)), true
+	case "Query.loanInfo":
+		if e.complexity.Query.LoanInfo == nil {
+			break
+		}
+
+		args, err := ec.field_Query_loanInfo_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.LoanInfo(childComplexity, args["loanCode"].([]string), args["filter"].(*model.RowFilterInput)), true
+	case "Query.property":
+		if e.complexity.Query.Property == nil {
+			break
+		}
+
+		return e.complexity.Query.Property(childComplexity), true
+	case "Query.ssotReportsAdministratorConfiguration":
+		if e.complexity.Query.SsotReportsAdministratorConfiguration == nil {
+			break
+		}
+
+		return e.complexity.Query.SsotReportsAdministratorConfiguration(childComplexity), true
+
+	case "SsotReportsAdministratorConfiguration.aclAuditLog":
+		if e.complexity.SsotReportsAdministratorConfiguration.ACLAuditLog == nil {
+			break
+		}
+
+		args, err := ec.field_SsotReportsAdministratorConfiguration_aclAuditLog_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.SsotReportsAdministratorConfiguration.ACLAuditLog(childComplexity, args["filter"].(*model.ACLAuditFilter), args["limit"].(int32)), true
+	case "SsotReportsAdministratorConfiguration.aclRecordHistory":
+		if e.complexity.SsotReportsAdministratorConfiguration.ACLRecordHistory == nil {
+			break
+		}
+
+		args, err := ec.field_SsotReportsAdministratorConfiguration_aclRecordHistory_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.SsotReportsAdministratorConfiguration.ACLRecordHistory(childComplexity, args["principalID"].(string)), true
+	case "SsotReportsAdministratorConfiguration.expiringGrants":
+		if e.complexity.SsotReportsAdministratorConfiguration.ExpiringGrants == nil {
+			break
+		}
+
+		args, err := ec.field_SsotReportsAdministratorConfiguration_expiringGrants_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.SsotReportsAdministratorConfiguration.ExpiringGrants(childComplexity, args["withinDays"].(int32)), true
+	case "SsotReportsAdministratorConfiguration.exportACL":
+		if e.complexity.SsotReportsAdministratorConfiguration.ExportACL == nil {
+			break
+		}
+
+		args, err := ec.field_SsotReportsAdministratorConfiguration_exportACL_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.SsotReportsAdministratorConfiguration.ExportACL(childComplexity, args["format"].(model.ACLFileFormat)), true
+	case "SsotReportsAdministratorConfiguration.listACLRecords":
+		if e.complexity.SsotReportsAdministratorConfiguration.ListACLRecords == nil {
 			break
 		}
 
@@ -499,9 +1310,18 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	opCtx := graphql.GetOperationContext(ctx)
 	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputACLAuditFilter,
 		ec.unmarshalInputAddGroupACLInput,
 		ec.unmarshalInputAddUserACLInput,
 		ec.unmarshalInputFieldFilterInput,
+		ec.unmarshalInputFilterRange,
+		ec.unmarshalInputGroupGrantInput,
+		ec.unmarshalInputLoanCashFlowAggregateFilter,
+		ec.unmarshalInputLoanCashFlowFilter,
+		ec.unmarshalInputLoanCashFlowMetricInput,
+		ec.unmarshalInputLoanCashFlowSort,
+		ec.unmarshalInputLoanInfoFilter,
+		ec.unmarshalInputMergeStrategyInput,
 		ec.unmarshalInputPermissionInput,
 		ec.unmarshalInputUpdateGroupACLInput,
 		ec.unmarshalInputUpdateUserACLInput,
@@ -621,7 +1441,7 @@ var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
 // region    ***************************** args.gotpl *****************************
 
-func (ec *executionContext) field_LoanCashFlows_byLoanCode_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_LoanCashFlows_byLoanCodeConnection_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "loanCode", ec.unmarshalNString2ᚕᚖstring)
@@ -629,36 +1449,122 @@ func (ec *executionContext) field_LoanCashFlows_byLoanCode_args(ctx context.Cont
 		return nil, err
 	}
 	args["loanCode"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "endDate", ec.unmarshalOString2ᚖstring)
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "endDate", ec.unmarshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate)
 	if err != nil {
 		return nil, err
 	}
 	args["endDate"] = arg1
-	return args, nil
-}
-
-func (ec *executionContext) field_Mutation_addGroupACL_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNAddGroupACLInput2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐAddGroupACLInput)
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "startDate", ec.unmarshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate)
 	if err != nil {
 		return nil, err
 	}
-	args["input"] = arg0
-	return args, nil
-}
-
-func (ec *executionContext) field_Mutation_addUserACL_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNAddUserACLInput2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐAddUserACLInput)
+	args["startDate"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "glPeriodStart", ec.unmarshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate)
 	if err != nil {
 		return nil, err
 	}
-	args["input"] = arg0
-	return args, nil
-}
-
+	args["glPeriodStart"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "glPeriodEnd", ec.unmarshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["glPeriodEnd"] = arg4
+	arg5, err := graphql.ProcessArgField(ctx, rawArgs, "order", ec.unmarshalOSortOrder2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐSortOrder)
+	if err != nil {
+		return nil, err
+	}
+	args["order"] = arg5
+	arg6, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOLoanCashFlowFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐRowFilterInput)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg6
+	arg7, err := graphql.ProcessArgField(ctx, rawArgs, "first", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg7
+	arg8, err := graphql.ProcessArgField(ctx, rawArgs, "after", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg8
+	return args, nil
+}
+
+func (ec *executionContext) field_LoanCashFlows_byLoanCode_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "loanCode", ec.unmarshalNString2ᚕᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["loanCode"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "endDate", ec.unmarshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["endDate"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "startDate", ec.unmarshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["startDate"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "glPeriodStart", ec.unmarshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["glPeriodStart"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "glPeriodEnd", ec.unmarshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["glPeriodEnd"] = arg4
+	arg5, err := graphql.ProcessArgField(ctx, rawArgs, "order", ec.unmarshalOSortOrder2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐSortOrder)
+	if err != nil {
+		return nil, err
+	}
+	args["order"] = arg5
+	arg6, err := graphql.ProcessArgField(ctx, rawArgs, "latest", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["latest"] = arg6
+	arg7, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOLoanCashFlowFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐRowFilterInput)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg7
+	arg8, err := graphql.ProcessArgField(ctx, rawArgs, "sort", ec.unmarshalOLoanCashFlowSort2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowSortᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["sort"] = arg8
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_addGroupACL_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNAddGroupACLInput2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐAddGroupACLInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_addUserACL_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNAddUserACLInput2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐAddUserACLInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_deleteGroupACL_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -681,6 +1587,48 @@ func (ec *executionContext) field_Mutation_deleteUserACL_args(ctx context.Contex
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_importACL_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "file", ec.unmarshalNUpload2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚐUpload)
+	if err != nil {
+		return nil, err
+	}
+	args["file"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "format", ec.unmarshalOACLFileFormat2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLFileFormat)
+	if err != nil {
+		return nil, err
+	}
+	args["format"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "mode", ec.unmarshalNACLImportMode2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLImportMode)
+	if err != nil {
+		return nil, err
+	}
+	args["mode"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "dryRun", ec.unmarshalNBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["dryRun"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_rollbackACL_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "principalID", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["principalID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "version", ec.unmarshalNInt2int32)
+	if err != nil {
+		return nil, err
+	}
+	args["version"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_updateGroupACL_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -703,6 +1651,58 @@ func (ec *executionContext) field_Mutation_updateUserACL_args(ctx context.Contex
 	return args, nil
 }
 
+func (ec *executionContext) field_Properties_byPropertyCode_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "propertyCode", ec.unmarshalNString2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["propertyCode"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Property_cashFlows_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "startDate", ec.unmarshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["startDate"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "endDate", ec.unmarshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["endDate"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "glPeriodStart", ec.unmarshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["glPeriodStart"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "glPeriodEnd", ec.unmarshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["glPeriodEnd"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "order", ec.unmarshalOSortOrder2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐSortOrder)
+	if err != nil {
+		return nil, err
+	}
+	args["order"] = arg4
+	arg5, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOLoanCashFlowFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐRowFilterInput)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg5
+	arg6, err := graphql.ProcessArgField(ctx, rawArgs, "sort", ec.unmarshalOLoanCashFlowSort2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowSortᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["sort"] = arg6
+	return args, nil
+}
+
 func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -714,6 +1714,92 @@ func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_loanCashFlowAggregate_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "groupBy", ec.unmarshalNString2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["groupBy"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "metrics", ec.unmarshalNLoanCashFlowMetricInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowMetricInputᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["metrics"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOLoanCashFlowAggregateFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowAggregateFilter)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_loanInfo_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "loanCode", ec.unmarshalOString2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["loanCode"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOLoanInfoFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐRowFilterInput)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_SsotReportsAdministratorConfiguration_aclAuditLog_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOACLAuditFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLAuditFilter)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalNInt2int32)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_SsotReportsAdministratorConfiguration_aclRecordHistory_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "principalID", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["principalID"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_SsotReportsAdministratorConfiguration_expiringGrants_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "withinDays", ec.unmarshalNInt2int32)
+	if err != nil {
+		return nil, err
+	}
+	args["withinDays"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_SsotReportsAdministratorConfiguration_exportACL_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "format", ec.unmarshalNACLFileFormat2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLFileFormat)
+	if err != nil {
+		return nil, err
+	}
+	args["format"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -766,54 +1852,54 @@ func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArg
 
 // region    **************************** field.gotpl *****************************
 
-func (ec *executionContext) _ACLMutationResult_success(ctx context.Context, field graphql.CollectedField, obj *model.ACLMutationResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLAuditChange_path(ctx context.Context, field graphql.CollectedField, obj *model.ACLAuditChange) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ACLMutationResult_success,
+		ec.fieldContext_ACLAuditChange_path,
 		func(ctx context.Context) (any, error) {
-			return obj.Success, nil
+			return obj.Path, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ACLMutationResult_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLAuditChange_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ACLMutationResult",
+		Object:     "ACLAuditChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ACLMutationResult_message(ctx context.Context, field graphql.CollectedField, obj *model.ACLMutationResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLAuditChange_before(ctx context.Context, field graphql.CollectedField, obj *model.ACLAuditChange) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ACLMutationResult_message,
+		ec.fieldContext_ACLAuditChange_before,
 		func(ctx context.Context) (any, error) {
-			return obj.Message, nil
+			return obj.Before, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ACLMutationResult_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLAuditChange_before(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ACLMutationResult",
+		Object:     "ACLAuditChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -824,55 +1910,43 @@ func (ec *executionContext) fieldContext_ACLMutationResult_message(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _ACLMutationResult_record(ctx context.Context, field graphql.CollectedField, obj *model.ACLMutationResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLAuditChange_after(ctx context.Context, field graphql.CollectedField, obj *model.ACLAuditChange) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ACLMutationResult_record,
+		ec.fieldContext_ACLAuditChange_after,
 		func(ctx context.Context) (any, error) {
-			return obj.Record, nil
+			return obj.After, nil
 		},
 		nil,
-		ec.marshalOACLRecord2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLRecord,
+		ec.marshalOString2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ACLMutationResult_record(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLAuditChange_after(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ACLMutationResult",
+		Object:     "ACLAuditChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "principalID":
-				return ec.fieldContext_ACLRecord_principalID(ctx, field)
-			case "groups":
-				return ec.fieldContext_ACLRecord_groups(ctx, field)
-			case "permissions":
-				return ec.fieldContext_ACLRecord_permissions(ctx, field)
-			case "fieldFilters":
-				return ec.fieldContext_ACLRecord_fieldFilters(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_ACLRecord_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ACLRecord", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ACLRecord_principalID(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecord) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLAuditEntry_entryID(ctx context.Context, field graphql.CollectedField, obj *model.ACLAuditEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ACLRecord_principalID,
+		ec.fieldContext_ACLAuditEntry_entryID,
 		func(ctx context.Context) (any, error) {
-			return obj.PrincipalID, nil
+			return obj.EntryID, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -881,9 +1955,9 @@ func (ec *executionContext) _ACLRecord_principalID(ctx context.Context, field gr
 	)
 }
 
-func (ec *executionContext) fieldContext_ACLRecord_principalID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLAuditEntry_entryID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ACLRecord",
+		Object:     "ACLAuditEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -894,25 +1968,25 @@ func (ec *executionContext) fieldContext_ACLRecord_principalID(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _ACLRecord_groups(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecord) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLAuditEntry_principalID(ctx context.Context, field graphql.CollectedField, obj *model.ACLAuditEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ACLRecord_groups,
+		ec.fieldContext_ACLAuditEntry_principalID,
 		func(ctx context.Context) (any, error) {
-			return obj.Groups, nil
+			return obj.PrincipalID, nil
 		},
 		nil,
-		ec.marshalNString2ᚕstringᚄ,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ACLRecord_groups(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLAuditEntry_principalID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ACLRecord",
+		Object:     "ACLAuditEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -923,204 +1997,196 @@ func (ec *executionContext) fieldContext_ACLRecord_groups(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _ACLRecord_permissions(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecord) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLAuditEntry_actor(ctx context.Context, field graphql.CollectedField, obj *model.ACLAuditEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ACLRecord_permissions,
+		ec.fieldContext_ACLAuditEntry_actor,
 		func(ctx context.Context) (any, error) {
-			return obj.Permissions, nil
+			return obj.Actor, nil
 		},
 		nil,
-		ec.marshalNPermission2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐPermissionᚄ,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ACLRecord_permissions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLAuditEntry_actor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ACLRecord",
+		Object:     "ACLAuditEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "table":
-				return ec.fieldContext_Permission_table(ctx, field)
-			case "action":
-				return ec.fieldContext_Permission_action(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Permission", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ACLRecord_fieldFilters(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecord) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLAuditEntry_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.ACLAuditEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ACLRecord_fieldFilters,
+		ec.fieldContext_ACLAuditEntry_timestamp,
 		func(ctx context.Context) (any, error) {
-			return obj.FieldFilters, nil
+			return obj.Timestamp, nil
 		},
 		nil,
-		ec.marshalNFieldFilter2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterᚄ,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ACLRecord_fieldFilters(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLAuditEntry_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ACLRecord",
+		Object:     "ACLAuditEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "field":
-				return ec.fieldContext_FieldFilter_field(ctx, field)
-			case "includeList":
-				return ec.fieldContext_FieldFilter_includeList(ctx, field)
-			case "excludeList":
-				return ec.fieldContext_FieldFilter_excludeList(ctx, field)
-			case "filterType":
-				return ec.fieldContext_FieldFilter_filterType(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type FieldFilter", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ACLRecord_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecord) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLAuditEntry_operation(ctx context.Context, field graphql.CollectedField, obj *model.ACLAuditEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ACLRecord_updatedAt,
+		ec.fieldContext_ACLAuditEntry_operation,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedAt, nil
+			return obj.Operation, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNACLAuditOperation2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLAuditOperation,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ACLRecord_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLAuditEntry_operation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ACLRecord",
+		Object:     "ACLAuditEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ACLAuditOperation does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FieldFilter_field(ctx context.Context, field graphql.CollectedField, obj *model.FieldFilter) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLAuditEntry_version(ctx context.Context, field graphql.CollectedField, obj *model.ACLAuditEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_FieldFilter_field,
+		ec.fieldContext_ACLAuditEntry_version,
 		func(ctx context.Context) (any, error) {
-			return obj.Field, nil
+			return obj.Version, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_FieldFilter_field(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLAuditEntry_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FieldFilter",
+		Object:     "ACLAuditEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FieldFilter_includeList(ctx context.Context, field graphql.CollectedField, obj *model.FieldFilter) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLAuditEntry_changes(ctx context.Context, field graphql.CollectedField, obj *model.ACLAuditEntry) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_FieldFilter_includeList,
+		ec.fieldContext_ACLAuditEntry_changes,
 		func(ctx context.Context) (any, error) {
-			return obj.IncludeList, nil
+			return obj.Changes, nil
 		},
 		nil,
-		ec.marshalNString2ᚕstringᚄ,
+		ec.marshalNACLAuditChange2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLAuditChangeᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_FieldFilter_includeList(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLAuditEntry_changes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FieldFilter",
+		Object:     "ACLAuditEntry",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "path":
+				return ec.fieldContext_ACLAuditChange_path(ctx, field)
+			case "before":
+				return ec.fieldContext_ACLAuditChange_before(ctx, field)
+			case "after":
+				return ec.fieldContext_ACLAuditChange_after(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ACLAuditChange", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FieldFilter_excludeList(ctx context.Context, field graphql.CollectedField, obj *model.FieldFilter) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLExport_format(ctx context.Context, field graphql.CollectedField, obj *model.ACLExport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_FieldFilter_excludeList,
+		ec.fieldContext_ACLExport_format,
 		func(ctx context.Context) (any, error) {
-			return obj.ExcludeList, nil
+			return obj.Format, nil
 		},
 		nil,
-		ec.marshalNString2ᚕstringᚄ,
+		ec.marshalNACLFileFormat2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLFileFormat,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_FieldFilter_excludeList(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLExport_format(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FieldFilter",
+		Object:     "ACLExport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ACLFileFormat does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FieldFilter_filterType(ctx context.Context, field graphql.CollectedField, obj *model.FieldFilter) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLExport_filename(ctx context.Context, field graphql.CollectedField, obj *model.ACLExport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_FieldFilter_filterType,
+		ec.fieldContext_ACLExport_filename,
 		func(ctx context.Context) (any, error) {
-			return obj.FilterType, nil
+			return obj.Filename, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1129,9 +2195,9 @@ func (ec *executionContext) _FieldFilter_filterType(ctx context.Context, field g
 	)
 }
 
-func (ec *executionContext) fieldContext_FieldFilter_filterType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLExport_filename(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FieldFilter",
+		Object:     "ACLExport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1142,14 +2208,14 @@ func (ec *executionContext) fieldContext_FieldFilter_filterType(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_loanCode(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLExport_contentType(ctx context.Context, field graphql.CollectedField, obj *model.ACLExport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_loanCode,
+		ec.fieldContext_ACLExport_contentType,
 		func(ctx context.Context) (any, error) {
-			return obj.LoanCode, nil
+			return obj.ContentType, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1158,9 +2224,9 @@ func (ec *executionContext) _LoanCashFlow_loanCode(ctx context.Context, field gr
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_loanCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLExport_contentType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLExport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1171,25 +2237,25 @@ func (ec *executionContext) fieldContext_LoanCashFlow_loanCode(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_maxHmy(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLExport_content(ctx context.Context, field graphql.CollectedField, obj *model.ACLExport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_maxHmy,
+		ec.fieldContext_ACLExport_content,
 		func(ctx context.Context) (any, error) {
-			return obj.MaxHmy, nil
+			return obj.Content, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_maxHmy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLExport_content(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLExport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1200,315 +2266,325 @@ func (ec *executionContext) fieldContext_LoanCashFlow_maxHmy(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_accrualEndDate(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLExport_rowCount(ctx context.Context, field graphql.CollectedField, obj *model.ACLExport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_accrualEndDate,
+		ec.fieldContext_ACLExport_rowCount,
 		func(ctx context.Context) (any, error) {
-			return obj.AccrualEndDate, nil
+			return obj.RowCount, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_accrualEndDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLExport_rowCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLExport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_accrualStartDate(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLImportResult_success(ctx context.Context, field graphql.CollectedField, obj *model.ACLImportResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_accrualStartDate,
+		ec.fieldContext_ACLImportResult_success,
 		func(ctx context.Context) (any, error) {
-			return obj.AccrualStartDate, nil
+			return obj.Success, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_accrualStartDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLImportResult_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLImportResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_balance(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLImportResult_applied(ctx context.Context, field graphql.CollectedField, obj *model.ACLImportResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_balance,
+		ec.fieldContext_ACLImportResult_applied,
 		func(ctx context.Context) (any, error) {
-			return obj.Balance, nil
+			return obj.Applied, nil
 		},
 		nil,
-		ec.marshalOFloat2ᚖfloat64,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_balance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLImportResult_applied(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLImportResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_capitalizedFee(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLImportResult_message(ctx context.Context, field graphql.CollectedField, obj *model.ACLImportResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_capitalizedFee,
+		ec.fieldContext_ACLImportResult_message,
 		func(ctx context.Context) (any, error) {
-			return obj.CapitalizedFee, nil
+			return obj.Message, nil
 		},
 		nil,
-		ec.marshalOFloat2ᚖfloat64,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_capitalizedFee(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLImportResult_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLImportResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_capitalizedInterest(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLImportResult_rows(ctx context.Context, field graphql.CollectedField, obj *model.ACLImportResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_capitalizedInterest,
+		ec.fieldContext_ACLImportResult_rows,
 		func(ctx context.Context) (any, error) {
-			return obj.CapitalizedInterest, nil
+			return obj.Rows, nil
 		},
 		nil,
-		ec.marshalOFloat2ᚖfloat64,
+		ec.marshalNACLImportRowResult2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLImportRowResultᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_capitalizedInterest(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLImportResult_rows(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLImportResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			switch field.Name {
+			case "line":
+				return ec.fieldContext_ACLImportRowResult_line(ctx, field)
+			case "principalID":
+				return ec.fieldContext_ACLImportRowResult_principalID(ctx, field)
+			case "status":
+				return ec.fieldContext_ACLImportRowResult_status(ctx, field)
+			case "message":
+				return ec.fieldContext_ACLImportRowResult_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ACLImportRowResult", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_capitalizedLoanAdministrationFee(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLImportRowResult_line(ctx context.Context, field graphql.CollectedField, obj *model.ACLImportRowResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_capitalizedLoanAdministrationFee,
+		ec.fieldContext_ACLImportRowResult_line,
 		func(ctx context.Context) (any, error) {
-			return obj.CapitalizedLoanAdministrationFee, nil
+			return obj.Line, nil
 		},
 		nil,
-		ec.marshalOFloat2ᚖfloat64,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_capitalizedLoanAdministrationFee(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLImportRowResult_line(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLImportRowResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_capitalizedOtherFees(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLImportRowResult_principalID(ctx context.Context, field graphql.CollectedField, obj *model.ACLImportRowResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_capitalizedOtherFees,
+		ec.fieldContext_ACLImportRowResult_principalID,
 		func(ctx context.Context) (any, error) {
-			return obj.CapitalizedOtherFees, nil
+			return obj.PrincipalID, nil
 		},
 		nil,
-		ec.marshalOFloat2ᚖfloat64,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_capitalizedOtherFees(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLImportRowResult_principalID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLImportRowResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_commitment(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLImportRowResult_status(ctx context.Context, field graphql.CollectedField, obj *model.ACLImportRowResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_commitment,
+		ec.fieldContext_ACLImportRowResult_status,
 		func(ctx context.Context) (any, error) {
-			return obj.Commitment, nil
+			return obj.Status, nil
 		},
 		nil,
-		ec.marshalOFloat2ᚖfloat64,
+		ec.marshalNACLImportRowStatus2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLImportRowStatus,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_commitment(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLImportRowResult_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLImportRowResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type ACLImportRowStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_drawActualPrincipal(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLImportRowResult_message(ctx context.Context, field graphql.CollectedField, obj *model.ACLImportRowResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_drawActualPrincipal,
+		ec.fieldContext_ACLImportRowResult_message,
 		func(ctx context.Context) (any, error) {
-			return obj.DrawActualPrincipal, nil
+			return obj.Message, nil
 		},
 		nil,
-		ec.marshalOFloat2ᚖfloat64,
+		ec.marshalOString2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_drawActualPrincipal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLImportRowResult_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLImportRowResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_eBalance(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLMutationResult_success(ctx context.Context, field graphql.CollectedField, obj *model.ACLMutationResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_eBalance,
+		ec.fieldContext_ACLMutationResult_success,
 		func(ctx context.Context) (any, error) {
-			return obj.EBalance, nil
+			return obj.Success, nil
 		},
 		nil,
-		ec.marshalOFloat2ᚖfloat64,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_eBalance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLMutationResult_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLMutationResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_glPeriodDate(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLMutationResult_message(ctx context.Context, field graphql.CollectedField, obj *model.ACLMutationResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_glPeriodDate,
+		ec.fieldContext_ACLMutationResult_message,
 		func(ctx context.Context) (any, error) {
-			return obj.GlPeriodDate, nil
+			return obj.Message, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_glPeriodDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLMutationResult_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLMutationResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1519,257 +2595,319 @@ func (ec *executionContext) fieldContext_LoanCashFlow_glPeriodDate(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_interest(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLMutationResult_conflict(ctx context.Context, field graphql.CollectedField, obj *model.ACLMutationResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_interest,
+		ec.fieldContext_ACLMutationResult_conflict,
 		func(ctx context.Context) (any, error) {
-			return obj.Interest, nil
+			return obj.Conflict, nil
 		},
 		nil,
-		ec.marshalOFloat2ᚖfloat64,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_interest(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLMutationResult_conflict(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLMutationResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_leverageActivity(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLMutationResult_record(ctx context.Context, field graphql.CollectedField, obj *model.ACLMutationResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_leverageActivity,
+		ec.fieldContext_ACLMutationResult_record,
 		func(ctx context.Context) (any, error) {
-			return obj.LeverageActivity, nil
+			return obj.Record, nil
 		},
 		nil,
-		ec.marshalOFloat2ᚖfloat64,
+		ec.marshalOACLRecord2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLRecord,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_leverageActivity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLMutationResult_record(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLMutationResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			switch field.Name {
+			case "principalID":
+				return ec.fieldContext_ACLRecord_principalID(ctx, field)
+			case "groups":
+				return ec.fieldContext_ACLRecord_groups(ctx, field)
+			case "permissions":
+				return ec.fieldContext_ACLRecord_permissions(ctx, field)
+			case "fieldFilters":
+				return ec.fieldContext_ACLRecord_fieldFilters(ctx, field)
+			case "mergeStrategies":
+				return ec.fieldContext_ACLRecord_mergeStrategies(ctx, field)
+			case "groupValidity":
+				return ec.fieldContext_ACLRecord_groupValidity(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_ACLRecord_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_ACLRecord_version(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ACLRecord", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_leverageBalance(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLRecord_principalID(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecord) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_leverageBalance,
+		ec.fieldContext_ACLRecord_principalID,
 		func(ctx context.Context) (any, error) {
-			return obj.LeverageBalance, nil
+			return obj.PrincipalID, nil
 		},
 		nil,
-		ec.marshalOFloat2ᚖfloat64,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_leverageBalance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLRecord_principalID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLRecord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_leverageInterest(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLRecord_groups(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecord) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_leverageInterest,
+		ec.fieldContext_ACLRecord_groups,
 		func(ctx context.Context) (any, error) {
-			return obj.LeverageInterest, nil
+			return obj.Groups, nil
 		},
 		nil,
-		ec.marshalOFloat2ᚖfloat64,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_leverageInterest(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLRecord_groups(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLRecord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_loanDesc(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLRecord_permissions(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecord) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_loanDesc,
+		ec.fieldContext_ACLRecord_permissions,
 		func(ctx context.Context) (any, error) {
-			return obj.LoanDesc, nil
+			return obj.Permissions, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNPermission2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐPermissionᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_loanDesc(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLRecord_permissions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLRecord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "table":
+				return ec.fieldContext_Permission_table(ctx, field)
+			case "column":
+				return ec.fieldContext_Permission_column(ctx, field)
+			case "action":
+				return ec.fieldContext_Permission_action(ctx, field)
+			case "validFrom":
+				return ec.fieldContext_Permission_validFrom(ctx, field)
+			case "validUntil":
+				return ec.fieldContext_Permission_validUntil(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Permission", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_paymentNumber(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLRecord_fieldFilters(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecord) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_paymentNumber,
+		ec.fieldContext_ACLRecord_fieldFilters,
 		func(ctx context.Context) (any, error) {
-			return obj.PaymentNumber, nil
+			return obj.FieldFilters, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNFieldFilter2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_paymentNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLRecord_fieldFilters(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLRecord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_FieldFilter_field(ctx, field)
+			case "includeList":
+				return ec.fieldContext_FieldFilter_includeList(ctx, field)
+			case "excludeList":
+				return ec.fieldContext_FieldFilter_excludeList(ctx, field)
+			case "filterType":
+				return ec.fieldContext_FieldFilter_filterType(ctx, field)
+			case "validFrom":
+				return ec.fieldContext_FieldFilter_validFrom(ctx, field)
+			case "validUntil":
+				return ec.fieldContext_FieldFilter_validUntil(ctx, field)
+			case "allOf":
+				return ec.fieldContext_FieldFilter_allOf(ctx, field)
+			case "anyOf":
+				return ec.fieldContext_FieldFilter_anyOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FieldFilter", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_postDate(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLRecord_mergeStrategies(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecord) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_postDate,
+		ec.fieldContext_ACLRecord_mergeStrategies,
 		func(ctx context.Context) (any, error) {
-			return obj.PostDate, nil
+			return obj.MergeStrategies, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNMergeStrategy2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐMergeStrategyᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_postDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLRecord_mergeStrategies(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLRecord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_MergeStrategy_field(ctx, field)
+			case "strategy":
+				return ec.fieldContext_MergeStrategy_strategy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MergeStrategy", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_propertyCode(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLRecord_groupValidity(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecord) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_propertyCode,
+		ec.fieldContext_ACLRecord_groupValidity,
 		func(ctx context.Context) (any, error) {
-			return obj.PropertyCode, nil
+			return obj.GroupValidity, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNGroupGrant2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐGroupGrantᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_propertyCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLRecord_groupValidity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLRecord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "group":
+				return ec.fieldContext_GroupGrant_group(ctx, field)
+			case "validFrom":
+				return ec.fieldContext_GroupGrant_validFrom(ctx, field)
+			case "validUntil":
+				return ec.fieldContext_GroupGrant_validUntil(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GroupGrant", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_propertyName(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLRecord_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecord) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_propertyName,
+		ec.fieldContext_ACLRecord_updatedAt,
 		func(ctx context.Context) (any, error) {
-			return obj.PropertyName, nil
+			return obj.UpdatedAt, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_propertyName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLRecord_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLRecord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1780,54 +2918,54 @@ func (ec *executionContext) fieldContext_LoanCashFlow_propertyName(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_sBalance(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLRecord_version(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecord) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_sBalance,
+		ec.fieldContext_ACLRecord_version,
 		func(ctx context.Context) (any, error) {
-			return obj.SBalance, nil
+			return obj.Version, nil
 		},
 		nil,
-		ec.marshalOFloat2ᚖfloat64,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_sBalance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLRecord_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLRecord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlow_status(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLRecordVersion_principalID(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecordVersion) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlow_status,
+		ec.fieldContext_ACLRecordVersion_principalID,
 		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
+			return obj.PrincipalID, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlow_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLRecordVersion_principalID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlow",
+		Object:     "ACLRecordVersion",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1838,399 +2976,235 @@ func (ec *executionContext) fieldContext_LoanCashFlow_status(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _LoanCashFlows_byLoanCode(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlows) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLRecordVersion_version(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecordVersion) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LoanCashFlows_byLoanCode,
+		ec.fieldContext_ACLRecordVersion_version,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.LoanCashFlows().ByLoanCode(ctx, obj, fc.Args["loanCode"].([]*string), fc.Args["endDate"].(*string))
+			return obj.Version, nil
 		},
 		nil,
-		ec.marshalNLoanCashFlow2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowᚄ,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_LoanCashFlows_byLoanCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLRecordVersion_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoanCashFlows",
+		Object:     "ACLRecordVersion",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "loanCode":
-				return ec.fieldContext_LoanCashFlow_loanCode(ctx, field)
-			case "maxHmy":
-				return ec.fieldContext_LoanCashFlow_maxHmy(ctx, field)
-			case "accrualEndDate":
-				return ec.fieldContext_LoanCashFlow_accrualEndDate(ctx, field)
-			case "accrualStartDate":
-				return ec.fieldContext_LoanCashFlow_accrualStartDate(ctx, field)
-			case "balance":
-				return ec.fieldContext_LoanCashFlow_balance(ctx, field)
-			case "capitalizedFee":
-				return ec.fieldContext_LoanCashFlow_capitalizedFee(ctx, field)
-			case "capitalizedInterest":
-				return ec.fieldContext_LoanCashFlow_capitalizedInterest(ctx, field)
-			case "capitalizedLoanAdministrationFee":
-				return ec.fieldContext_LoanCashFlow_capitalizedLoanAdministrationFee(ctx, field)
-			case "capitalizedOtherFees":
-				return ec.fieldContext_LoanCashFlow_capitalizedOtherFees(ctx, field)
-			case "commitment":
-				return ec.fieldContext_LoanCashFlow_commitment(ctx, field)
-			case "drawActualPrincipal":
-				return ec.fieldContext_LoanCashFlow_drawActualPrincipal(ctx, field)
-			case "eBalance":
-				return ec.fieldContext_LoanCashFlow_eBalance(ctx, field)
-			case "glPeriodDate":
-				return ec.fieldContext_LoanCashFlow_glPeriodDate(ctx, field)
-			case "interest":
-				return ec.fieldContext_LoanCashFlow_interest(ctx, field)
-			case "leverageActivity":
-				return ec.fieldContext_LoanCashFlow_leverageActivity(ctx, field)
-			case "leverageBalance":
-				return ec.fieldContext_LoanCashFlow_leverageBalance(ctx, field)
-			case "leverageInterest":
-				return ec.fieldContext_LoanCashFlow_leverageInterest(ctx, field)
-			case "loanDesc":
-				return ec.fieldContext_LoanCashFlow_loanDesc(ctx, field)
-			case "paymentNumber":
-				return ec.fieldContext_LoanCashFlow_paymentNumber(ctx, field)
-			case "postDate":
-				return ec.fieldContext_LoanCashFlow_postDate(ctx, field)
-			case "propertyCode":
-				return ec.fieldContext_LoanCashFlow_propertyCode(ctx, field)
-			case "propertyName":
-				return ec.fieldContext_LoanCashFlow_propertyName(ctx, field)
-			case "sBalance":
-				return ec.fieldContext_LoanCashFlow_sBalance(ctx, field)
-			case "status":
-				return ec.fieldContext_LoanCashFlow_status(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoanCashFlow", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_LoanCashFlows_byLoanCode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_addUserACL(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLRecordVersion_record(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecordVersion) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_addUserACL,
+		ec.fieldContext_ACLRecordVersion_record,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().AddUserACL(ctx, fc.Args["input"].(model.AddUserACLInput))
+			return obj.Record, nil
 		},
 		nil,
-		ec.marshalNACLMutationResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLMutationResult,
-		true,
+		ec.marshalOACLRecord2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLRecord,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_addUserACL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLRecordVersion_record(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ACLRecordVersion",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "success":
-				return ec.fieldContext_ACLMutationResult_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ACLMutationResult_message(ctx, field)
-			case "record":
-				return ec.fieldContext_ACLMutationResult_record(ctx, field)
+			case "principalID":
+				return ec.fieldContext_ACLRecord_principalID(ctx, field)
+			case "groups":
+				return ec.fieldContext_ACLRecord_groups(ctx, field)
+			case "permissions":
+				return ec.fieldContext_ACLRecord_permissions(ctx, field)
+			case "fieldFilters":
+				return ec.fieldContext_ACLRecord_fieldFilters(ctx, field)
+			case "mergeStrategies":
+				return ec.fieldContext_ACLRecord_mergeStrategies(ctx, field)
+			case "groupValidity":
+				return ec.fieldContext_ACLRecord_groupValidity(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_ACLRecord_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_ACLRecord_version(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ACLMutationResult", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ACLRecord", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_addUserACL_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateUserACL(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLRecordVersion_actor(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecordVersion) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateUserACL,
+		ec.fieldContext_ACLRecordVersion_actor,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateUserACL(ctx, fc.Args["input"].(model.UpdateUserACLInput))
+			return obj.Actor, nil
 		},
 		nil,
-		ec.marshalNACLMutationResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLMutationResult,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateUserACL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLRecordVersion_actor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ACLRecordVersion",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ACLMutationResult_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ACLMutationResult_message(ctx, field)
-			case "record":
-				return ec.fieldContext_ACLMutationResult_record(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ACLMutationResult", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateUserACL_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_addGroupACL(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLRecordVersion_operation(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecordVersion) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_addGroupACL,
+		ec.fieldContext_ACLRecordVersion_operation,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().AddGroupACL(ctx, fc.Args["input"].(model.AddGroupACLInput))
+			return obj.Operation, nil
 		},
 		nil,
-		ec.marshalNACLMutationResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLMutationResult,
-		true,
+		ec.marshalOACLAuditOperation2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLAuditOperation,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_addGroupACL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLRecordVersion_operation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ACLRecordVersion",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ACLMutationResult_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ACLMutationResult_message(ctx, field)
-			case "record":
-				return ec.fieldContext_ACLMutationResult_record(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ACLMutationResult", field.Name)
+			return nil, errors.New("field of type ACLAuditOperation does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_addGroupACL_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateGroupACL(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ACLRecordVersion_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.ACLRecordVersion) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateGroupACL,
+		ec.fieldContext_ACLRecordVersion_createdAt,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateGroupACL(ctx, fc.Args["input"].(model.UpdateGroupACLInput))
+			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalNACLMutationResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLMutationResult,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateGroupACL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ACLRecordVersion_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ACLRecordVersion",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ACLMutationResult_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ACLMutationResult_message(ctx, field)
-			case "record":
-				return ec.fieldContext_ACLMutationResult_record(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ACLMutationResult", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateGroupACL_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteUserACL(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExpiringGrant_principalID(ctx context.Context, field graphql.CollectedField, obj *model.ExpiringGrant) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_deleteUserACL,
+		ec.fieldContext_ExpiringGrant_principalID,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().DeleteUserACL(ctx, fc.Args["email"].(string))
+			return obj.PrincipalID, nil
 		},
 		nil,
-		ec.marshalNACLMutationResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLMutationResult,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteUserACL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExpiringGrant_principalID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ExpiringGrant",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ACLMutationResult_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ACLMutationResult_message(ctx, field)
-			case "record":
-				return ec.fieldContext_ACLMutationResult_record(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ACLMutationResult", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteUserACL_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteGroupACL(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExpiringGrant_kind(ctx context.Context, field graphql.CollectedField, obj *model.ExpiringGrant) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_deleteGroupACL,
+		ec.fieldContext_ExpiringGrant_kind,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().DeleteGroupACL(ctx, fc.Args["groupName"].(string))
+			return obj.Kind, nil
 		},
 		nil,
-		ec.marshalNACLMutationResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLMutationResult,
+		ec.marshalNGrantKind2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐGrantKind,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteGroupACL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExpiringGrant_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ExpiringGrant",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ACLMutationResult_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ACLMutationResult_message(ctx, field)
-			case "record":
-				return ec.fieldContext_ACLMutationResult_record(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ACLMutationResult", field.Name)
+			return nil, errors.New("field of type GrantKind does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteGroupACL_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Permission_table(ctx context.Context, field graphql.CollectedField, obj *model.Permission) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExpiringGrant_name(ctx context.Context, field graphql.CollectedField, obj *model.ExpiringGrant) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Permission_table,
+		ec.fieldContext_ExpiringGrant_name,
 		func(ctx context.Context) (any, error) {
-			return obj.Table, nil
+			return obj.Name, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -2239,9 +3213,9 @@ func (ec *executionContext) _Permission_table(ctx context.Context, field graphql
 	)
 }
 
-func (ec *executionContext) fieldContext_Permission_table(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExpiringGrant_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Permission",
+		Object:     "ExpiringGrant",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2252,14 +3226,14 @@ func (ec *executionContext) fieldContext_Permission_table(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Permission_action(ctx context.Context, field graphql.CollectedField, obj *model.Permission) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExpiringGrant_validUntil(ctx context.Context, field graphql.CollectedField, obj *model.ExpiringGrant) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Permission_action,
+		ec.fieldContext_ExpiringGrant_validUntil,
 		func(ctx context.Context) (any, error) {
-			return obj.Action, nil
+			return obj.ValidUntil, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -2268,9 +3242,9 @@ func (ec *executionContext) _Permission_action(ctx context.Context, field graphq
 	)
 }
 
-func (ec *executionContext) fieldContext_Permission_action(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExpiringGrant_validUntil(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Permission",
+		Object:     "ExpiringGrant",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2281,240 +3255,170 @@ func (ec *executionContext) fieldContext_Permission_action(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_loanCashFlow(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _FieldFilter_field(ctx context.Context, field graphql.CollectedField, obj *model.FieldFilter) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_loanCashFlow,
+		ec.fieldContext_FieldFilter_field,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().LoanCashFlow(ctx)
+			return obj.Field, nil
 		},
 		nil,
-		ec.marshalNLoanCashFlows2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlows,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_loanCashFlow(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FieldFilter_field(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "FieldFilter",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "byLoanCode":
-				return ec.fieldContext_LoanCashFlows_byLoanCode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoanCashFlows", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_ssotReportsAdministratorConfiguration(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _FieldFilter_includeList(ctx context.Context, field graphql.CollectedField, obj *model.FieldFilter) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_ssotReportsAdministratorConfiguration,
+		ec.fieldContext_FieldFilter_includeList,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().SsotReportsAdministratorConfiguration(ctx)
+			return obj.IncludeList, nil
 		},
 		nil,
-		ec.marshalNSsotReportsAdministratorConfiguration2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐSsotReportsAdministratorConfiguration,
+		ec.marshalNString2ᚕstringᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_ssotReportsAdministratorConfiguration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FieldFilter_includeList(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "FieldFilter",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "listACLRecords":
-				return ec.fieldContext_SsotReportsAdministratorConfiguration_listACLRecords(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SsotReportsAdministratorConfiguration", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _FieldFilter_excludeList(ctx context.Context, field graphql.CollectedField, obj *model.FieldFilter) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query___type,
+		ec.fieldContext_FieldFilter_excludeList,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.introspectType(fc.Args["name"].(string))
+			return obj.ExcludeList, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FieldFilter_excludeList(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "FieldFilter",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _FieldFilter_filterType(ctx context.Context, field graphql.CollectedField, obj *model.FieldFilter) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query___schema,
+		ec.fieldContext_FieldFilter_filterType,
 		func(ctx context.Context) (any, error) {
-			return ec.introspectSchema()
+			return obj.FilterType, nil
 		},
 		nil,
-		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FieldFilter_filterType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "FieldFilter",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SsotReportsAdministratorConfiguration_listACLRecords(ctx context.Context, field graphql.CollectedField, obj *model.SsotReportsAdministratorConfiguration) (ret graphql.Marshaler) {
+func (ec *executionContext) _FieldFilter_validFrom(ctx context.Context, field graphql.CollectedField, obj *model.FieldFilter) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SsotReportsAdministratorConfiguration_listACLRecords,
+		ec.fieldContext_FieldFilter_validFrom,
 		func(ctx context.Context) (any, error) {
-			return obj.ListACLRecords, nil
+			return obj.ValidFrom, nil
 		},
 		nil,
-		ec.marshalNACLRecord2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLRecordᚄ,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_SsotReportsAdministratorConfiguration_listACLRecords(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FieldFilter_validFrom(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SsotReportsAdministratorConfiguration",
+		Object:     "FieldFilter",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "principalID":
-				return ec.fieldContext_ACLRecord_principalID(ctx, field)
-			case "groups":
-				return ec.fieldContext_ACLRecord_groups(ctx, field)
-			case "permissions":
-				return ec.fieldContext_ACLRecord_permissions(ctx, field)
-			case "fieldFilters":
-				return ec.fieldContext_ACLRecord_fieldFilters(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_ACLRecord_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ACLRecord", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _FieldFilter_validUntil(ctx context.Context, field graphql.CollectedField, obj *model.FieldFilter) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_name,
+		ec.fieldContext_FieldFilter_validUntil,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.ValidUntil, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FieldFilter_validUntil(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "FieldFilter",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2525,155 +3429,195 @@ func (ec *executionContext) fieldContext___Directive_name(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _FieldFilter_allOf(ctx context.Context, field graphql.CollectedField, obj *model.FieldFilter) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_description,
+		ec.fieldContext_FieldFilter_allOf,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.AllOf, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOFieldFilter2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterᚄ,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FieldFilter_allOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "FieldFilter",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_FieldFilter_field(ctx, field)
+			case "includeList":
+				return ec.fieldContext_FieldFilter_includeList(ctx, field)
+			case "excludeList":
+				return ec.fieldContext_FieldFilter_excludeList(ctx, field)
+			case "filterType":
+				return ec.fieldContext_FieldFilter_filterType(ctx, field)
+			case "validFrom":
+				return ec.fieldContext_FieldFilter_validFrom(ctx, field)
+			case "validUntil":
+				return ec.fieldContext_FieldFilter_validUntil(ctx, field)
+			case "allOf":
+				return ec.fieldContext_FieldFilter_allOf(ctx, field)
+			case "anyOf":
+				return ec.fieldContext_FieldFilter_anyOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FieldFilter", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _FieldFilter_anyOf(ctx context.Context, field graphql.CollectedField, obj *model.FieldFilter) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_isRepeatable,
+		ec.fieldContext_FieldFilter_anyOf,
 		func(ctx context.Context) (any, error) {
-			return obj.IsRepeatable, nil
+			return obj.AnyOf, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
-		true,
+		ec.marshalOFieldFilter2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterᚄ,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FieldFilter_anyOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "FieldFilter",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_FieldFilter_field(ctx, field)
+			case "includeList":
+				return ec.fieldContext_FieldFilter_includeList(ctx, field)
+			case "excludeList":
+				return ec.fieldContext_FieldFilter_excludeList(ctx, field)
+			case "filterType":
+				return ec.fieldContext_FieldFilter_filterType(ctx, field)
+			case "validFrom":
+				return ec.fieldContext_FieldFilter_validFrom(ctx, field)
+			case "validUntil":
+				return ec.fieldContext_FieldFilter_validUntil(ctx, field)
+			case "allOf":
+				return ec.fieldContext_FieldFilter_allOf(ctx, field)
+			case "anyOf":
+				return ec.fieldContext_FieldFilter_anyOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FieldFilter", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _GroupGrant_group(ctx context.Context, field graphql.CollectedField, obj *model.GroupGrant) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_locations,
+		ec.fieldContext_GroupGrant_group,
 		func(ctx context.Context) (any, error) {
-			return obj.Locations, nil
+			return obj.Group, nil
 		},
 		nil,
-		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GroupGrant_group(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "GroupGrant",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _GroupGrant_validFrom(ctx context.Context, field graphql.CollectedField, obj *model.GroupGrant) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_args,
+		ec.fieldContext_GroupGrant_validFrom,
 		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
+			return obj.ValidFrom, nil
 		},
 		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GroupGrant_validFrom(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "GroupGrant",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+	return fc, nil
+}
+
+func (ec *executionContext) _GroupGrant_validUntil(ctx context.Context, field graphql.CollectedField, obj *model.GroupGrant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GroupGrant_validUntil,
+		func(ctx context.Context) (any, error) {
+			return obj.ValidUntil, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GroupGrant_validUntil(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GroupGrant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_loanCode(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_name,
+		ec.fieldContext_LoanCashFlow_loanCode,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.LoanCode, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -2682,9 +3626,9 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_loanCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "LoanCashFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2695,14 +3639,14 @@ func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_maxHmy(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_description,
+		ec.fieldContext_LoanCashFlow_maxHmy,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.MaxHmy, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -2711,11 +3655,11 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_maxHmy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "LoanCashFlow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -2724,804 +3668,623 @@ func (ec *executionContext) fieldContext___EnumValue_description(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_accrualEndDate(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_isDeprecated,
+		ec.fieldContext_LoanCashFlow_accrualEndDate,
 		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
+			return obj.AccrualEndDate, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
-		true,
+		ec.marshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_accrualEndDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "LoanCashFlow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_accrualStartDate(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_deprecationReason,
+		ec.fieldContext_LoanCashFlow_accrualStartDate,
 		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
+			return obj.AccrualStartDate, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_accrualStartDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "LoanCashFlow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_balance(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_name,
+		ec.fieldContext_LoanCashFlow_balance,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.Balance, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalODecimal2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDecimal,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_balance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "LoanCashFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_capitalizedFee(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_description,
+		ec.fieldContext_LoanCashFlow_capitalizedFee,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.CapitalizedFee, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalODecimal2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDecimal,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_capitalizedFee(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "LoanCashFlow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_capitalizedInterest(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_args,
+		ec.fieldContext_LoanCashFlow_capitalizedInterest,
 		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
+			return obj.CapitalizedInterest, nil
 		},
 		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
+		ec.marshalODecimal2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDecimal,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_capitalizedInterest(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "LoanCashFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_capitalizedLoanAdministrationFee(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_type,
+		ec.fieldContext_LoanCashFlow_capitalizedLoanAdministrationFee,
 		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
+			return obj.CapitalizedLoanAdministrationFee, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
+		ec.marshalODecimal2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDecimal,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_capitalizedLoanAdministrationFee(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "LoanCashFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_capitalizedOtherFees(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_isDeprecated,
+		ec.fieldContext_LoanCashFlow_capitalizedOtherFees,
 		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
+			return obj.CapitalizedOtherFees, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
-		true,
+		ec.marshalODecimal2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDecimal,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_capitalizedOtherFees(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "LoanCashFlow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_commitment(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_deprecationReason,
+		ec.fieldContext_LoanCashFlow_commitment,
 		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
+			return obj.Commitment, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalODecimal2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDecimal,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_commitment(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "LoanCashFlow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_drawActualPrincipal(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_name,
+		ec.fieldContext_LoanCashFlow_drawActualPrincipal,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.DrawActualPrincipal, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalODecimal2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDecimal,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_drawActualPrincipal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "LoanCashFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_eBalance(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_description,
+		ec.fieldContext_LoanCashFlow_eBalance,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.EBalance, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalODecimal2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDecimal,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_eBalance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "LoanCashFlow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_glPeriodDate(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_type,
+		ec.fieldContext_LoanCashFlow_glPeriodDate,
 		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
+			return obj.GlPeriodDate, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
+		ec.marshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_glPeriodDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "LoanCashFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_interest(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_defaultValue,
+		ec.fieldContext_LoanCashFlow_interest,
 		func(ctx context.Context) (any, error) {
-			return obj.DefaultValue, nil
+			return obj.Interest, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalODecimal2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDecimal,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_interest(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "LoanCashFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_leverageActivity(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_isDeprecated,
+		ec.fieldContext_LoanCashFlow_leverageActivity,
 		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
+			return obj.LeverageActivity, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
-		true,
+		ec.marshalODecimal2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDecimal,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_leverageActivity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "LoanCashFlow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_leverageBalance(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_deprecationReason,
+		ec.fieldContext_LoanCashFlow_leverageBalance,
 		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
+			return obj.LeverageBalance, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalODecimal2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDecimal,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_leverageBalance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "LoanCashFlow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_leverageInterest(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_description,
+		ec.fieldContext_LoanCashFlow_leverageInterest,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.LeverageInterest, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalODecimal2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDecimal,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_leverageInterest(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "LoanCashFlow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_loanDesc(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_types,
+		ec.fieldContext_LoanCashFlow_loanDesc,
 		func(ctx context.Context) (any, error) {
-			return obj.Types(), nil
+			return obj.LoanDesc, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_loanDesc(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "LoanCashFlow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_paymentNumber(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_queryType,
+		ec.fieldContext_LoanCashFlow_paymentNumber,
 		func(ctx context.Context) (any, error) {
-			return obj.QueryType(), nil
+			return obj.PaymentNumber, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_paymentNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "LoanCashFlow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_postDate(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_mutationType,
+		ec.fieldContext_LoanCashFlow_postDate,
 		func(ctx context.Context) (any, error) {
-			return obj.MutationType(), nil
+			return obj.PostDate, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalODateTime2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDateTime,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_postDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "LoanCashFlow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_propertyCode(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_subscriptionType,
+		ec.fieldContext_LoanCashFlow_propertyCode,
 		func(ctx context.Context) (any, error) {
-			return obj.SubscriptionType(), nil
+			return obj.PropertyCode, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalOString2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_propertyCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "LoanCashFlow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_propertyName(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_directives,
+		ec.fieldContext_LoanCashFlow_propertyName,
 		func(ctx context.Context) (any, error) {
-			return obj.Directives(), nil
+			return obj.PropertyName, nil
 		},
 		nil,
-		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_propertyName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "LoanCashFlow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_sBalance(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_kind,
+		ec.fieldContext_LoanCashFlow_sBalance,
 		func(ctx context.Context) (any, error) {
-			return obj.Kind(), nil
+			return obj.SBalance, nil
 		},
 		nil,
-		ec.marshalN__TypeKind2string,
-		true,
+		ec.marshalODecimal2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDecimal,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_sBalance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "LoanCashFlow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_status(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_name,
+		ec.fieldContext_LoanCashFlow_status,
 		func(ctx context.Context) (any, error) {
-			return obj.Name(), nil
+			return obj.Status, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -3530,11 +4293,11 @@ func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.Coll
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "LoanCashFlow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -3543,696 +4306,6839 @@ func (ec *executionContext) fieldContext___Type_name(_ context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlow_loanInfo(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_description,
+		ec.fieldContext_LoanCashFlow_loanInfo,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return ec.resolvers.LoanCashFlow().LoanInfo(ctx, obj)
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOLoanInfo2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanInfo,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlow_loanInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "LoanCashFlow",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "loanCode":
+				return ec.fieldContext_LoanInfo_loanCode(ctx, field)
+			case "loanName":
+				return ec.fieldContext_LoanInfo_loanName(ctx, field)
+			case "loanStatus":
+				return ec.fieldContext_LoanInfo_loanStatus(ctx, field)
+			case "loanType":
+				return ec.fieldContext_LoanInfo_loanType(ctx, field)
+			case "borrower":
+				return ec.fieldContext_LoanInfo_borrower(ctx, field)
+			case "lender":
+				return ec.fieldContext_LoanInfo_lender(ctx, field)
+			case "fund":
+				return ec.fieldContext_LoanInfo_fund(ctx, field)
+			case "propertyCode":
+				return ec.fieldContext_LoanInfo_propertyCode(ctx, field)
+			case "propertyName":
+				return ec.fieldContext_LoanInfo_propertyName(ctx, field)
+			case "originationDate":
+				return ec.fieldContext_LoanInfo_originationDate(ctx, field)
+			case "maturityDate":
+				return ec.fieldContext_LoanInfo_maturityDate(ctx, field)
+			case "commitmentAmount":
+				return ec.fieldContext_LoanInfo_commitmentAmount(ctx, field)
+			case "interestRate":
+				return ec.fieldContext_LoanInfo_interestRate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoanInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlowAggregateGroup_key(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlowAggregateGroup) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_specifiedByURL,
+		ec.fieldContext_LoanCashFlowAggregateGroup_key,
 		func(ctx context.Context) (any, error) {
-			return obj.SpecifiedByURL(), nil
+			return obj.Key, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNLoanCashFlowGroupKey2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowGroupKeyᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlowAggregateGroup_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "LoanCashFlowAggregateGroup",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_LoanCashFlowGroupKey_field(ctx, field)
+			case "value":
+				return ec.fieldContext_LoanCashFlowGroupKey_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoanCashFlowGroupKey", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlowAggregateGroup_count(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlowAggregateGroup) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_fields,
+		ec.fieldContext_LoanCashFlowAggregateGroup_count,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+			return obj.Count, nil
 		},
 		nil,
-		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlowAggregateGroup_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "LoanCashFlowAggregateGroup",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlowAggregateGroup_metrics(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlowAggregateGroup) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_interfaces,
+		ec.fieldContext_LoanCashFlowAggregateGroup_metrics,
 		func(ctx context.Context) (any, error) {
-			return obj.Interfaces(), nil
+			return obj.Metrics, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		ec.marshalNLoanCashFlowMetricValue2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowMetricValueᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlowAggregateGroup_metrics(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "LoanCashFlowAggregateGroup",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			case "field":
+				return ec.fieldContext_LoanCashFlowMetricValue_field(ctx, field)
+			case "function":
+				return ec.fieldContext_LoanCashFlowMetricValue_function(ctx, field)
+			case "value":
+				return ec.fieldContext_LoanCashFlowMetricValue_value(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoanCashFlowMetricValue", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlowConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlowConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_possibleTypes,
+		ec.fieldContext_LoanCashFlowConnection_edges,
 		func(ctx context.Context) (any, error) {
-			return obj.PossibleTypes(), nil
+			return obj.Edges, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		ec.marshalNLoanCashFlowEdge2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowEdgeᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlowConnection_edges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "LoanCashFlowConnection",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			case "cursor":
+				return ec.fieldContext_LoanCashFlowEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_LoanCashFlowEdge_node(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoanCashFlowEdge", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlowConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlowConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_enumValues,
+		ec.fieldContext_LoanCashFlowConnection_pageInfo,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+			return obj.PageInfo, nil
 		},
 		nil,
-		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
+		ec.marshalNPageInfo2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐPageInfo,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlowConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "LoanCashFlowConnection",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+	return fc, nil
+}
+
+func (ec *executionContext) _LoanCashFlowEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlowEdge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanCashFlowEdge_cursor,
+		func(ctx context.Context) (any, error) {
+			return obj.Cursor, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LoanCashFlowEdge_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanCashFlowEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlowEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlowEdge) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_inputFields,
+		ec.fieldContext_LoanCashFlowEdge_node,
 		func(ctx context.Context) (any, error) {
-			return obj.InputFields(), nil
+			return obj.Node, nil
 		},
 		nil,
-		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		ec.marshalNLoanCashFlow2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlow,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlowEdge_node(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "LoanCashFlowEdge",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			case "loanCode":
+				return ec.fieldContext_LoanCashFlow_loanCode(ctx, field)
+			case "maxHmy":
+				return ec.fieldContext_LoanCashFlow_maxHmy(ctx, field)
+			case "accrualEndDate":
+				return ec.fieldContext_LoanCashFlow_accrualEndDate(ctx, field)
+			case "accrualStartDate":
+				return ec.fieldContext_LoanCashFlow_accrualStartDate(ctx, field)
+			case "balance":
+				return ec.fieldContext_LoanCashFlow_balance(ctx, field)
+			case "capitalizedFee":
+				return ec.fieldContext_LoanCashFlow_capitalizedFee(ctx, field)
+			case "capitalizedInterest":
+				return ec.fieldContext_LoanCashFlow_capitalizedInterest(ctx, field)
+			case "capitalizedLoanAdministrationFee":
+				return ec.fieldContext_LoanCashFlow_capitalizedLoanAdministrationFee(ctx, field)
+			case "capitalizedOtherFees":
+				return ec.fieldContext_LoanCashFlow_capitalizedOtherFees(ctx, field)
+			case "commitment":
+				return ec.fieldContext_LoanCashFlow_commitment(ctx, field)
+			case "drawActualPrincipal":
+				return ec.fieldContext_LoanCashFlow_drawActualPrincipal(ctx, field)
+			case "eBalance":
+				return ec.fieldContext_LoanCashFlow_eBalance(ctx, field)
+			case "glPeriodDate":
+				return ec.fieldContext_LoanCashFlow_glPeriodDate(ctx, field)
+			case "interest":
+				return ec.fieldContext_LoanCashFlow_interest(ctx, field)
+			case "leverageActivity":
+				return ec.fieldContext_LoanCashFlow_leverageActivity(ctx, field)
+			case "leverageBalance":
+				return ec.fieldContext_LoanCashFlow_leverageBalance(ctx, field)
+			case "leverageInterest":
+				return ec.fieldContext_LoanCashFlow_leverageInterest(ctx, field)
+			case "loanDesc":
+				return ec.fieldContext_LoanCashFlow_loanDesc(ctx, field)
+			case "paymentNumber":
+				return ec.fieldContext_LoanCashFlow_paymentNumber(ctx, field)
+			case "postDate":
+				return ec.fieldContext_LoanCashFlow_postDate(ctx, field)
+			case "propertyCode":
+				return ec.fieldContext_LoanCashFlow_propertyCode(ctx, field)
+			case "propertyName":
+				return ec.fieldContext_LoanCashFlow_propertyName(ctx, field)
+			case "sBalance":
+				return ec.fieldContext_LoanCashFlow_sBalance(ctx, field)
+			case "status":
+				return ec.fieldContext_LoanCashFlow_status(ctx, field)
+			case "loanInfo":
+				return ec.fieldContext_LoanCashFlow_loanInfo(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoanCashFlow", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlowGroupKey_field(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlowGroupKey) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_ofType,
+		ec.fieldContext_LoanCashFlowGroupKey_field,
 		func(ctx context.Context) (any, error) {
-			return obj.OfType(), nil
+			return obj.Field, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlowGroupKey_field(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "LoanCashFlowGroupKey",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _LoanCashFlowGroupKey_value(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlowGroupKey) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_isOneOf,
+		ec.fieldContext_LoanCashFlowGroupKey_value,
 		func(ctx context.Context) (any, error) {
-			return obj.IsOneOf(), nil
+			return obj.Value, nil
 		},
 		nil,
-		ec.marshalOBoolean2bool,
+		ec.marshalOString2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoanCashFlowGroupKey_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "LoanCashFlowGroupKey",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
+func (ec *executionContext) _LoanCashFlowMetricValue_field(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlowMetricValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanCashFlowMetricValue_field,
+		func(ctx context.Context) (any, error) {
+			return obj.Field, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
 
-func (ec *executionContext) unmarshalInputAddGroupACLInput(ctx context.Context, obj any) (model.AddGroupACLInput, error) {
-	var it model.AddGroupACLInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+func (ec *executionContext) fieldContext_LoanCashFlowMetricValue_field(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanCashFlowMetricValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
+	return fc, nil
+}
 
-	fieldsInOrder := [...]string{"groupName", "permissions", "fieldFilters"}
-	for _, k := range fieldsInOrder {
+func (ec *executionContext) _LoanCashFlowMetricValue_function(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlowMetricValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanCashFlowMetricValue_function,
+		func(ctx context.Context) (any, error) {
+			return obj.Function, nil
+		},
+		nil,
+		ec.marshalNAggregateFunction2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐAggregateFunction,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LoanCashFlowMetricValue_function(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanCashFlowMetricValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type AggregateFunction does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoanCashFlowMetricValue_value(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlowMetricValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanCashFlowMetricValue_value,
+		func(ctx context.Context) (any, error) {
+			return obj.Value, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDecimal,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LoanCashFlowMetricValue_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanCashFlowMetricValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoanCashFlows_byLoanCode(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlows) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanCashFlows_byLoanCode,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.LoanCashFlows().ByLoanCode(ctx, obj, fc.Args["loanCode"].([]*string), fc.Args["endDate"].(*model.Date), fc.Args["startDate"].(*model.Date), fc.Args["glPeriodStart"].(*model.Date), fc.Args["glPeriodEnd"].(*model.Date), fc.Args["order"].(*model.SortOrder), fc.Args["latest"].(*int32), fc.Args["filter"].(*model.RowFilterInput), fc.Args["sort"].([]*model.LoanCashFlowSort))
+		},
+		nil,
+		ec.marshalNLoanCashFlow2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LoanCashFlows_byLoanCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanCashFlows",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "loanCode":
+				return ec.fieldContext_LoanCashFlow_loanCode(ctx, field)
+			case "maxHmy":
+				return ec.fieldContext_LoanCashFlow_maxHmy(ctx, field)
+			case "accrualEndDate":
+				return ec.fieldContext_LoanCashFlow_accrualEndDate(ctx, field)
+			case "accrualStartDate":
+				return ec.fieldContext_LoanCashFlow_accrualStartDate(ctx, field)
+			case "balance":
+				return ec.fieldContext_LoanCashFlow_balance(ctx, field)
+			case "capitalizedFee":
+				return ec.fieldContext_LoanCashFlow_capitalizedFee(ctx, field)
+			case "capitalizedInterest":
+				return ec.fieldContext_LoanCashFlow_capitalizedInterest(ctx, field)
+			case "capitalizedLoanAdministrationFee":
+				return ec.fieldContext_LoanCashFlow_capitalizedLoanAdministrationFee(ctx, field)
+			case "capitalizedOtherFees":
+				return ec.fieldContext_LoanCashFlow_capitalizedOtherFees(ctx, field)
+			case "commitment":
+				return ec.fieldContext_LoanCashFlow_commitment(ctx, field)
+			case "drawActualPrincipal":
+				return ec.fieldContext_LoanCashFlow_drawActualPrincipal(ctx, field)
+			case "eBalance":
+				return ec.fieldContext_LoanCashFlow_eBalance(ctx, field)
+			case "glPeriodDate":
+				return ec.fieldContext_LoanCashFlow_glPeriodDate(ctx, field)
+			case "interest":
+				return ec.fieldContext_LoanCashFlow_interest(ctx, field)
+			case "leverageActivity":
+				return ec.fieldContext_LoanCashFlow_leverageActivity(ctx, field)
+			case "leverageBalance":
+				return ec.fieldContext_LoanCashFlow_leverageBalance(ctx, field)
+			case "leverageInterest":
+				return ec.fieldContext_LoanCashFlow_leverageInterest(ctx, field)
+			case "loanDesc":
+				return ec.fieldContext_LoanCashFlow_loanDesc(ctx, field)
+			case "paymentNumber":
+				return ec.fieldContext_LoanCashFlow_paymentNumber(ctx, field)
+			case "postDate":
+				return ec.fieldContext_LoanCashFlow_postDate(ctx, field)
+			case "propertyCode":
+				return ec.fieldContext_LoanCashFlow_propertyCode(ctx, field)
+			case "propertyName":
+				return ec.fieldContext_LoanCashFlow_propertyName(ctx, field)
+			case "sBalance":
+				return ec.fieldContext_LoanCashFlow_sBalance(ctx, field)
+			case "status":
+				return ec.fieldContext_LoanCashFlow_status(ctx, field)
+			case "loanInfo":
+				return ec.fieldContext_LoanCashFlow_loanInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoanCashFlow", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_LoanCashFlows_byLoanCode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoanCashFlows_byLoanCodeConnection(ctx context.Context, field graphql.CollectedField, obj *model.LoanCashFlows) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanCashFlows_byLoanCodeConnection,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.LoanCashFlows().ByLoanCodeConnection(ctx, obj, fc.Args["loanCode"].([]*string), fc.Args["endDate"].(*model.Date), fc.Args["startDate"].(*model.Date), fc.Args["glPeriodStart"].(*model.Date), fc.Args["glPeriodEnd"].(*model.Date), fc.Args["order"].(*model.SortOrder), fc.Args["filter"].(*model.RowFilterInput), fc.Args["first"].(*int32), fc.Args["after"].(*string))
+		},
+		nil,
+		ec.marshalNLoanCashFlowConnection2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowConnection,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LoanCashFlows_byLoanCodeConnection(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanCashFlows",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_LoanCashFlowConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_LoanCashFlowConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoanCashFlowConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_LoanCashFlows_byLoanCodeConnection_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoanInfo_loanCode(ctx context.Context, field graphql.CollectedField, obj *model.LoanInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanInfo_loanCode,
+		func(ctx context.Context) (any, error) {
+			return obj.LoanCode, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LoanInfo_loanCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoanInfo_loanName(ctx context.Context, field graphql.CollectedField, obj *model.LoanInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanInfo_loanName,
+		func(ctx context.Context) (any, error) {
+			return obj.LoanName, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LoanInfo_loanName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoanInfo_loanStatus(ctx context.Context, field graphql.CollectedField, obj *model.LoanInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanInfo_loanStatus,
+		func(ctx context.Context) (any, error) {
+			return obj.LoanStatus, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LoanInfo_loanStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoanInfo_loanType(ctx context.Context, field graphql.CollectedField, obj *model.LoanInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanInfo_loanType,
+		func(ctx context.Context) (any, error) {
+			return obj.LoanType, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LoanInfo_loanType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoanInfo_borrower(ctx context.Context, field graphql.CollectedField, obj *model.LoanInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanInfo_borrower,
+		func(ctx context.Context) (any, error) {
+			return obj.Borrower, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LoanInfo_borrower(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoanInfo_lender(ctx context.Context, field graphql.CollectedField, obj *model.LoanInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanInfo_lender,
+		func(ctx context.Context) (any, error) {
+			return obj.Lender, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LoanInfo_lender(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoanInfo_fund(ctx context.Context, field graphql.CollectedField, obj *model.LoanInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanInfo_fund,
+		func(ctx context.Context) (any, error) {
+			return obj.Fund, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LoanInfo_fund(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoanInfo_propertyCode(ctx context.Context, field graphql.CollectedField, obj *model.LoanInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanInfo_propertyCode,
+		func(ctx context.Context) (any, error) {
+			return obj.PropertyCode, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LoanInfo_propertyCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoanInfo_propertyName(ctx context.Context, field graphql.CollectedField, obj *model.LoanInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanInfo_propertyName,
+		func(ctx context.Context) (any, error) {
+			return obj.PropertyName, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LoanInfo_propertyName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoanInfo_originationDate(ctx context.Context, field graphql.CollectedField, obj *model.LoanInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanInfo_originationDate,
+		func(ctx context.Context) (any, error) {
+			return obj.OriginationDate, nil
+		},
+		nil,
+		ec.marshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LoanInfo_originationDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoanInfo_maturityDate(ctx context.Context, field graphql.CollectedField, obj *model.LoanInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanInfo_maturityDate,
+		func(ctx context.Context) (any, error) {
+			return obj.MaturityDate, nil
+		},
+		nil,
+		ec.marshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LoanInfo_maturityDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoanInfo_commitmentAmount(ctx context.Context, field graphql.CollectedField, obj *model.LoanInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanInfo_commitmentAmount,
+		func(ctx context.Context) (any, error) {
+			return obj.CommitmentAmount, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDecimal,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LoanInfo_commitmentAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoanInfo_interestRate(ctx context.Context, field graphql.CollectedField, obj *model.LoanInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LoanInfo_interestRate,
+		func(ctx context.Context) (any, error) {
+			return obj.InterestRate, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDecimal,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LoanInfo_interestRate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoanInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MergeStrategy_field(ctx context.Context, field graphql.CollectedField, obj *model.MergeStrategy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MergeStrategy_field,
+		func(ctx context.Context) (any, error) {
+			return obj.Field, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_MergeStrategy_field(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MergeStrategy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MergeStrategy_strategy(ctx context.Context, field graphql.CollectedField, obj *model.MergeStrategy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MergeStrategy_strategy,
+		func(ctx context.Context) (any, error) {
+			return obj.Strategy, nil
+		},
+		nil,
+		ec.marshalNFieldFilterMergeStrategy2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterMergeStrategy,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_MergeStrategy_strategy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MergeStrategy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type FieldFilterMergeStrategy does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_addUserACL(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_addUserACL,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().AddUserACL(ctx, fc.Args["input"].(model.AddUserACLInput))
+		},
+		nil,
+		ec.marshalNACLMutationResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLMutationResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_addUserACL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ACLMutationResult_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ACLMutationResult_message(ctx, field)
+			case "conflict":
+				return ec.fieldContext_ACLMutationResult_conflict(ctx, field)
+			case "record":
+				return ec.fieldContext_ACLMutationResult_record(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ACLMutationResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_addUserACL_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateUserACL(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_updateUserACL,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdateUserACL(ctx, fc.Args["input"].(model.UpdateUserACLInput))
+		},
+		nil,
+		ec.marshalNACLMutationResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLMutationResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateUserACL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ACLMutationResult_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ACLMutationResult_message(ctx, field)
+			case "conflict":
+				return ec.fieldContext_ACLMutationResult_conflict(ctx, field)
+			case "record":
+				return ec.fieldContext_ACLMutationResult_record(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ACLMutationResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateUserACL_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_addGroupACL(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_addGroupACL,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().AddGroupACL(ctx, fc.Args["input"].(model.AddGroupACLInput))
+		},
+		nil,
+		ec.marshalNACLMutationResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLMutationResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_addGroupACL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ACLMutationResult_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ACLMutationResult_message(ctx, field)
+			case "conflict":
+				return ec.fieldContext_ACLMutationResult_conflict(ctx, field)
+			case "record":
+				return ec.fieldContext_ACLMutationResult_record(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ACLMutationResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_addGroupACL_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateGroupACL(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_updateGroupACL,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdateGroupACL(ctx, fc.Args["input"].(model.UpdateGroupACLInput))
+		},
+		nil,
+		ec.marshalNACLMutationResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLMutationResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateGroupACL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ACLMutationResult_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ACLMutationResult_message(ctx, field)
+			case "conflict":
+				return ec.fieldContext_ACLMutationResult_conflict(ctx, field)
+			case "record":
+				return ec.fieldContext_ACLMutationResult_record(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ACLMutationResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateGroupACL_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_deleteUserACL(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_deleteUserACL,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DeleteUserACL(ctx, fc.Args["email"].(string))
+		},
+		nil,
+		ec.marshalNACLMutationResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLMutationResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_deleteUserACL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ACLMutationResult_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ACLMutationResult_message(ctx, field)
+			case "conflict":
+				return ec.fieldContext_ACLMutationResult_conflict(ctx, field)
+			case "record":
+				return ec.fieldContext_ACLMutationResult_record(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ACLMutationResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteUserACL_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_deleteGroupACL(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_deleteGroupACL,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DeleteGroupACL(ctx, fc.Args["groupName"].(string))
+		},
+		nil,
+		ec.marshalNACLMutationResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLMutationResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_deleteGroupACL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ACLMutationResult_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ACLMutationResult_message(ctx, field)
+			case "conflict":
+				return ec.fieldContext_ACLMutationResult_conflict(ctx, field)
+			case "record":
+				return ec.fieldContext_ACLMutationResult_record(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ACLMutationResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteGroupACL_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_rollbackACL(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_rollbackACL,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RollbackACL(ctx, fc.Args["principalID"].(string), fc.Args["version"].(int32))
+		},
+		nil,
+		ec.marshalNACLMutationResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLMutationResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_rollbackACL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ACLMutationResult_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ACLMutationResult_message(ctx, field)
+			case "conflict":
+				return ec.fieldContext_ACLMutationResult_conflict(ctx, field)
+			case "record":
+				return ec.fieldContext_ACLMutationResult_record(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ACLMutationResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_rollbackACL_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_importACL(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_importACL,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ImportACL(ctx, fc.Args["file"].(graphql.Upload), fc.Args["format"].(*model.ACLFileFormat), fc.Args["mode"].(model.ACLImportMode), fc.Args["dryRun"].(bool))
+		},
+		nil,
+		ec.marshalNACLImportResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLImportResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_importACL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ACLImportResult_success(ctx, field)
+			case "applied":
+				return ec.fieldContext_ACLImportResult_applied(ctx, field)
+			case "message":
+				return ec.fieldContext_ACLImportResult_message(ctx, field)
+			case "rows":
+				return ec.fieldContext_ACLImportResult_rows(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ACLImportResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_importACL_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_hasNextPage,
+		func(ctx context.Context) (any, error) {
+			return obj.HasNextPage, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_hasNextPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_hasPreviousPage,
+		func(ctx context.Context) (any, error) {
+			return obj.HasPreviousPage, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_hasPreviousPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_startCursor,
+		func(ctx context.Context) (any, error) {
+			return obj.StartCursor, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_startCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_endCursor,
+		func(ctx context.Context) (any, error) {
+			return obj.EndCursor, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_endCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Permission_table(ctx context.Context, field graphql.CollectedField, obj *model.Permission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Permission_table,
+		func(ctx context.Context) (any, error) {
+			return obj.Table, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Permission_table(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Permission",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Permission_column(ctx context.Context, field graphql.CollectedField, obj *model.Permission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Permission_column,
+		func(ctx context.Context) (any, error) {
+			return obj.Column, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Permission_column(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Permission",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Permission_action(ctx context.Context, field graphql.CollectedField, obj *model.Permission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Permission_action,
+		func(ctx context.Context) (any, error) {
+			return obj.Action, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Permission_action(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Permission",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Permission_validFrom(ctx context.Context, field graphql.CollectedField, obj *model.Permission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Permission_validFrom,
+		func(ctx context.Context) (any, error) {
+			return obj.ValidFrom, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Permission_validFrom(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Permission",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Permission_validUntil(ctx context.Context, field graphql.CollectedField, obj *model.Permission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Permission_validUntil,
+		func(ctx context.Context) (any, error) {
+			return obj.ValidUntil, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Permission_validUntil(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Permission",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Properties_byPropertyCode(ctx context.Context, field graphql.CollectedField, obj *model.Properties) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Properties_byPropertyCode,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Properties().ByPropertyCode(ctx, obj, fc.Args["propertyCode"].([]string))
+		},
+		nil,
+		ec.marshalNProperty2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐPropertyᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Properties_byPropertyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Properties",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "propertyCode":
+				return ec.fieldContext_Property_propertyCode(ctx, field)
+			case "propertyName":
+				return ec.fieldContext_Property_propertyName(ctx, field)
+			case "loans":
+				return ec.fieldContext_Property_loans(ctx, field)
+			case "cashFlows":
+				return ec.fieldContext_Property_cashFlows(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Properties_byPropertyCode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Property_propertyCode(ctx context.Context, field graphql.CollectedField, obj *model.Property) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Property_propertyCode,
+		func(ctx context.Context) (any, error) {
+			return obj.PropertyCode, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Property_propertyCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Property",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Property_propertyName(ctx context.Context, field graphql.CollectedField, obj *model.Property) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Property_propertyName,
+		func(ctx context.Context) (any, error) {
+			return obj.PropertyName, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Property_propertyName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Property",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Property_loans(ctx context.Context, field graphql.CollectedField, obj *model.Property) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Property_loans,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Property().Loans(ctx, obj)
+		},
+		nil,
+		ec.marshalNLoanInfo2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanInfoᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Property_loans(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Property",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "loanCode":
+				return ec.fieldContext_LoanInfo_loanCode(ctx, field)
+			case "loanName":
+				return ec.fieldContext_LoanInfo_loanName(ctx, field)
+			case "loanStatus":
+				return ec.fieldContext_LoanInfo_loanStatus(ctx, field)
+			case "loanType":
+				return ec.fieldContext_LoanInfo_loanType(ctx, field)
+			case "borrower":
+				return ec.fieldContext_LoanInfo_borrower(ctx, field)
+			case "lender":
+				return ec.fieldContext_LoanInfo_lender(ctx, field)
+			case "fund":
+				return ec.fieldContext_LoanInfo_fund(ctx, field)
+			case "propertyCode":
+				return ec.fieldContext_LoanInfo_propertyCode(ctx, field)
+			case "propertyName":
+				return ec.fieldContext_LoanInfo_propertyName(ctx, field)
+			case "originationDate":
+				return ec.fieldContext_LoanInfo_originationDate(ctx, field)
+			case "maturityDate":
+				return ec.fieldContext_LoanInfo_maturityDate(ctx, field)
+			case "commitmentAmount":
+				return ec.fieldContext_LoanInfo_commitmentAmount(ctx, field)
+			case "interestRate":
+				return ec.fieldContext_LoanInfo_interestRate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoanInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Property_cashFlows(ctx context.Context, field graphql.CollectedField, obj *model.Property) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Property_cashFlows,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Property().CashFlows(ctx, obj, fc.Args["startDate"].(*model.Date), fc.Args["endDate"].(*model.Date), fc.Args["glPeriodStart"].(*model.Date), fc.Args["glPeriodEnd"].(*model.Date), fc.Args["order"].(*model.SortOrder), fc.Args["filter"].(*model.RowFilterInput), fc.Args["sort"].([]*model.LoanCashFlowSort))
+		},
+		nil,
+		ec.marshalNLoanCashFlow2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Property_cashFlows(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Property",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "loanCode":
+				return ec.fieldContext_LoanCashFlow_loanCode(ctx, field)
+			case "maxHmy":
+				return ec.fieldContext_LoanCashFlow_maxHmy(ctx, field)
+			case "accrualEndDate":
+				return ec.fieldContext_LoanCashFlow_accrualEndDate(ctx, field)
+			case "accrualStartDate":
+				return ec.fieldContext_LoanCashFlow_accrualStartDate(ctx, field)
+			case "balance":
+				return ec.fieldContext_LoanCashFlow_balance(ctx, field)
+			case "capitalizedFee":
+				return ec.fieldContext_LoanCashFlow_capitalizedFee(ctx, field)
+			case "capitalizedInterest":
+				return ec.fieldContext_LoanCashFlow_capitalizedInterest(ctx, field)
+			case "capitalizedLoanAdministrationFee":
+				return ec.fieldContext_LoanCashFlow_capitalizedLoanAdministrationFee(ctx, field)
+			case "capitalizedOtherFees":
+				return ec.fieldContext_LoanCashFlow_capitalizedOtherFees(ctx, field)
+			case "commitment":
+				return ec.fieldContext_LoanCashFlow_commitment(ctx, field)
+			case "drawActualPrincipal":
+				return ec.fieldContext_LoanCashFlow_drawActualPrincipal(ctx, field)
+			case "eBalance":
+				return ec.fieldContext_LoanCashFlow_eBalance(ctx, field)
+			case "glPeriodDate":
+				return ec.fieldContext_LoanCashFlow_glPeriodDate(ctx, field)
+			case "interest":
+				return ec.fieldContext_LoanCashFlow_interest(ctx, field)
+			case "leverageActivity":
+				return ec.fieldContext_LoanCashFlow_leverageActivity(ctx, field)
+			case "leverageBalance":
+				return ec.fieldContext_LoanCashFlow_leverageBalance(ctx, field)
+			case "leverageInterest":
+				return ec.fieldContext_LoanCashFlow_leverageInterest(ctx, field)
+			case "loanDesc":
+				return ec.fieldContext_LoanCashFlow_loanDesc(ctx, field)
+			case "paymentNumber":
+				return ec.fieldContext_LoanCashFlow_paymentNumber(ctx, field)
+			case "postDate":
+				return ec.fieldContext_LoanCashFlow_postDate(ctx, field)
+			case "propertyCode":
+				return ec.fieldContext_LoanCashFlow_propertyCode(ctx, field)
+			case "propertyName":
+				return ec.fieldContext_LoanCashFlow_propertyName(ctx, field)
+			case "sBalance":
+				return ec.fieldContext_LoanCashFlow_sBalance(ctx, field)
+			case "status":
+				return ec.fieldContext_LoanCashFlow_status(ctx, field)
+			case "loanInfo":
+				return ec.fieldContext_LoanCashFlow_loanInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoanCashFlow", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Property_cashFlows_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_loanCashFlow(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_loanCashFlow,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().LoanCashFlow(ctx)
+		},
+		nil,
+		ec.marshalNLoanCashFlows2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlows,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_loanCashFlow(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "byLoanCode":
+				return ec.fieldContext_LoanCashFlows_byLoanCode(ctx, field)
+			case "byLoanCodeConnection":
+				return ec.fieldContext_LoanCashFlows_byLoanCodeConnection(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoanCashFlows", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_loanCashFlowAggregate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_loanCashFlowAggregate,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().LoanCashFlowAggregate(ctx, fc.Args["groupBy"].([]string), fc.Args["metrics"].([]*model.LoanCashFlowMetricInput), fc.Args["filter"].(*model.LoanCashFlowAggregateFilter))
+		},
+		nil,
+		ec.marshalNLoanCashFlowAggregateGroup2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowAggregateGroupᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_loanCashFlowAggregate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "key":
+				return ec.fieldContext_LoanCashFlowAggregateGroup_key(ctx, field)
+			case "count":
+				return ec.fieldContext_LoanCashFlowAggregateGroup_count(ctx, field)
+			case "metrics":
+				return ec.fieldContext_LoanCashFlowAggregateGroup_metrics(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoanCashFlowAggregateGroup", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_loanCashFlowAggregate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_loanInfo(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_loanInfo,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().LoanInfo(ctx, fc.Args["loanCode"].([]string), fc.Args["filter"].(*model.RowFilterInput))
+		},
+		nil,
+		ec.marshalNLoanInfo2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanInfoᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_loanInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "loanCode":
+				return ec.fieldContext_LoanInfo_loanCode(ctx, field)
+			case "loanName":
+				return ec.fieldContext_LoanInfo_loanName(ctx, field)
+			case "loanStatus":
+				return ec.fieldContext_LoanInfo_loanStatus(ctx, field)
+			case "loanType":
+				return ec.fieldContext_LoanInfo_loanType(ctx, field)
+			case "borrower":
+				return ec.fieldContext_LoanInfo_borrower(ctx, field)
+			case "lender":
+				return ec.fieldContext_LoanInfo_lender(ctx, field)
+			case "fund":
+				return ec.fieldContext_LoanInfo_fund(ctx, field)
+			case "propertyCode":
+				return ec.fieldContext_LoanInfo_propertyCode(ctx, field)
+			case "propertyName":
+				return ec.fieldContext_LoanInfo_propertyName(ctx, field)
+			case "originationDate":
+				return ec.fieldContext_LoanInfo_originationDate(ctx, field)
+			case "maturityDate":
+				return ec.fieldContext_LoanInfo_maturityDate(ctx, field)
+			case "commitmentAmount":
+				return ec.fieldContext_LoanInfo_commitmentAmount(ctx, field)
+			case "interestRate":
+				return ec.fieldContext_LoanInfo_interestRate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoanInfo", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_loanInfo_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_property(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_property,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().Property(ctx)
+		},
+		nil,
+		ec.marshalNProperties2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐProperties,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_property(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "byPropertyCode":
+				return ec.fieldContext_Properties_byPropertyCode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Properties", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_ssotReportsAdministratorConfiguration(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_ssotReportsAdministratorConfiguration,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().SsotReportsAdministratorConfiguration(ctx)
+		},
+		nil,
+		ec.marshalNSsotReportsAdministratorConfiguration2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐSsotReportsAdministratorConfiguration,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_ssotReportsAdministratorConfiguration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "listACLRecords":
+				return ec.fieldContext_SsotReportsAdministratorConfiguration_listACLRecords(ctx, field)
+			case "expiringGrants":
+				return ec.fieldContext_SsotReportsAdministratorConfiguration_expiringGrants(ctx, field)
+			case "aclAuditLog":
+				return ec.fieldContext_SsotReportsAdministratorConfiguration_aclAuditLog(ctx, field)
+			case "aclRecordHistory":
+				return ec.fieldContext_SsotReportsAdministratorConfiguration_aclRecordHistory(ctx, field)
+			case "exportACL":
+				return ec.fieldContext_SsotReportsAdministratorConfiguration_exportACL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SsotReportsAdministratorConfiguration", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___type,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.introspectType(fc.Args["name"].(string))
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___schema,
+		func(ctx context.Context) (any, error) {
+			return ec.introspectSchema()
+		},
+		nil,
+		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SsotReportsAdministratorConfiguration_listACLRecords(ctx context.Context, field graphql.CollectedField, obj *model.SsotReportsAdministratorConfiguration) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SsotReportsAdministratorConfiguration_listACLRecords,
+		func(ctx context.Context) (any, error) {
+			return obj.ListACLRecords, nil
+		},
+		nil,
+		ec.marshalNACLRecord2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLRecordᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SsotReportsAdministratorConfiguration_listACLRecords(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SsotReportsAdministratorConfiguration",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "principalID":
+				return ec.fieldContext_ACLRecord_principalID(ctx, field)
+			case "groups":
+				return ec.fieldContext_ACLRecord_groups(ctx, field)
+			case "permissions":
+				return ec.fieldContext_ACLRecord_permissions(ctx, field)
+			case "fieldFilters":
+				return ec.fieldContext_ACLRecord_fieldFilters(ctx, field)
+			case "mergeStrategies":
+				return ec.fieldContext_ACLRecord_mergeStrategies(ctx, field)
+			case "groupValidity":
+				return ec.fieldContext_ACLRecord_groupValidity(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_ACLRecord_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_ACLRecord_version(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ACLRecord", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SsotReportsAdministratorConfiguration_expiringGrants(ctx context.Context, field graphql.CollectedField, obj *model.SsotReportsAdministratorConfiguration) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SsotReportsAdministratorConfiguration_expiringGrants,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.SsotReportsAdministratorConfiguration().ExpiringGrants(ctx, obj, fc.Args["withinDays"].(int32))
+		},
+		nil,
+		ec.marshalNExpiringGrant2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐExpiringGrantᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SsotReportsAdministratorConfiguration_expiringGrants(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SsotReportsAdministratorConfiguration",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "principalID":
+				return ec.fieldContext_ExpiringGrant_principalID(ctx, field)
+			case "kind":
+				return ec.fieldContext_ExpiringGrant_kind(ctx, field)
+			case "name":
+				return ec.fieldContext_ExpiringGrant_name(ctx, field)
+			case "validUntil":
+				return ec.fieldContext_ExpiringGrant_validUntil(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ExpiringGrant", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_SsotReportsAdministratorConfiguration_expiringGrants_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SsotReportsAdministratorConfiguration_aclAuditLog(ctx context.Context, field graphql.CollectedField, obj *model.SsotReportsAdministratorConfiguration) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SsotReportsAdministratorConfiguration_aclAuditLog,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.SsotReportsAdministratorConfiguration().ACLAuditLog(ctx, obj, fc.Args["filter"].(*model.ACLAuditFilter), fc.Args["limit"].(int32))
+		},
+		nil,
+		ec.marshalNACLAuditEntry2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLAuditEntryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SsotReportsAdministratorConfiguration_aclAuditLog(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SsotReportsAdministratorConfiguration",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "entryID":
+				return ec.fieldContext_ACLAuditEntry_entryID(ctx, field)
+			case "principalID":
+				return ec.fieldContext_ACLAuditEntry_principalID(ctx, field)
+			case "actor":
+				return ec.fieldContext_ACLAuditEntry_actor(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_ACLAuditEntry_timestamp(ctx, field)
+			case "operation":
+				return ec.fieldContext_ACLAuditEntry_operation(ctx, field)
+			case "version":
+				return ec.fieldContext_ACLAuditEntry_version(ctx, field)
+			case "changes":
+				return ec.fieldContext_ACLAuditEntry_changes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ACLAuditEntry", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_SsotReportsAdministratorConfiguration_aclAuditLog_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SsotReportsAdministratorConfiguration_aclRecordHistory(ctx context.Context, field graphql.CollectedField, obj *model.SsotReportsAdministratorConfiguration) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SsotReportsAdministratorConfiguration_aclRecordHistory,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.SsotReportsAdministratorConfiguration().ACLRecordHistory(ctx, obj, fc.Args["principalID"].(string))
+		},
+		nil,
+		ec.marshalNACLRecordVersion2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLRecordVersionᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SsotReportsAdministratorConfiguration_aclRecordHistory(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SsotReportsAdministratorConfiguration",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "principalID":
+				return ec.fieldContext_ACLRecordVersion_principalID(ctx, field)
+			case "version":
+				return ec.fieldContext_ACLRecordVersion_version(ctx, field)
+			case "record":
+				return ec.fieldContext_ACLRecordVersion_record(ctx, field)
+			case "actor":
+				return ec.fieldContext_ACLRecordVersion_actor(ctx, field)
+			case "operation":
+				return ec.fieldContext_ACLRecordVersion_operation(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ACLRecordVersion_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ACLRecordVersion", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_SsotReportsAdministratorConfiguration_aclRecordHistory_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SsotReportsAdministratorConfiguration_exportACL(ctx context.Context, field graphql.CollectedField, obj *model.SsotReportsAdministratorConfiguration) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SsotReportsAdministratorConfiguration_exportACL,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.SsotReportsAdministratorConfiguration().ExportACL(ctx, obj, fc.Args["format"].(model.ACLFileFormat))
+		},
+		nil,
+		ec.marshalNACLExport2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLExport,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SsotReportsAdministratorConfiguration_exportACL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SsotReportsAdministratorConfiguration",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "format":
+				return ec.fieldContext_ACLExport_format(ctx, field)
+			case "filename":
+				return ec.fieldContext_ACLExport_filename(ctx, field)
+			case "contentType":
+				return ec.fieldContext_ACLExport_contentType(ctx, field)
+			case "content":
+				return ec.fieldContext_ACLExport_content(ctx, field)
+			case "rowCount":
+				return ec.fieldContext_ACLExport_rowCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ACLExport", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_SsotReportsAdministratorConfiguration_exportACL_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_isRepeatable,
+		func(ctx context.Context) (any, error) {
+			return obj.IsRepeatable, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_locations,
+		func(ctx context.Context) (any, error) {
+			return obj.Locations, nil
+		},
+		nil,
+		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_defaultValue,
+		func(ctx context.Context) (any, error) {
+			return obj.DefaultValue, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_types,
+		func(ctx context.Context) (any, error) {
+			return obj.Types(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_queryType,
+		func(ctx context.Context) (any, error) {
+			return obj.QueryType(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_mutationType,
+		func(ctx context.Context) (any, error) {
+			return obj.MutationType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_subscriptionType,
+		func(ctx context.Context) (any, error) {
+			return obj.SubscriptionType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_directives,
+		func(ctx context.Context) (any, error) {
+			return obj.Directives(), nil
+		},
+		nil,
+		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind(), nil
+		},
+		nil,
+		ec.marshalN__TypeKind2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_specifiedByURL,
+		func(ctx context.Context) (any, error) {
+			return obj.SpecifiedByURL(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_fields,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_interfaces,
+		func(ctx context.Context) (any, error) {
+			return obj.Interfaces(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_possibleTypes,
+		func(ctx context.Context) (any, error) {
+			return obj.PossibleTypes(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_enumValues,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_inputFields,
+		func(ctx context.Context) (any, error) {
+			return obj.InputFields(), nil
+		},
+		nil,
+		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_ofType,
+		func(ctx context.Context) (any, error) {
+			return obj.OfType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_isOneOf,
+		func(ctx context.Context) (any, error) {
+			return obj.IsOneOf(), nil
+		},
+		nil,
+		ec.marshalOBoolean2bool,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputACLAuditFilter(ctx context.Context, obj any) (model.ACLAuditFilter, error) {
+	var it model.ACLAuditFilter
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"principalID", "actor", "operation", "from", "until"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "principalID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("principalID"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PrincipalID = data
+		case "actor":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("actor"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Actor = data
+		case "operation":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("operation"))
+			data, err := ec.unmarshalOACLAuditOperation2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLAuditOperation(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Operation = data
+		case "from":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.From = data
+		case "until":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("until"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Until = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAddGroupACLInput(ctx context.Context, obj any) (model.AddGroupACLInput, error) {
+	var it model.AddGroupACLInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"groupName", "groups", "groupValidity", "permissions", "fieldFilters", "mergeStrategies"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "groupName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groupName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GroupName = data
+		case "groups":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groups"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Groups = data
+		case "groupValidity":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groupValidity"))
+			data, err := ec.unmarshalOGroupGrantInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐGroupGrantInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GroupValidity = data
+		case "permissions":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("permissions"))
+			data, err := ec.unmarshalNPermissionInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐPermissionInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Permissions = data
+		case "fieldFilters":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fieldFilters"))
+			data, err := ec.unmarshalOFieldFilterInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FieldFilters = data
+		case "mergeStrategies":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mergeStrategies"))
+			data, err := ec.unmarshalOMergeStrategyInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐMergeStrategyInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MergeStrategies = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAddUserACLInput(ctx context.Context, obj any) (model.AddUserACLInput, error) {
+	var it model.AddUserACLInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"email", "groups", "groupValidity", "permissions", "fieldFilters"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "email":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Email = data
+		case "groups":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groups"))
+			data, err := ec.unmarshalNString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Groups = data
+		case "groupValidity":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groupValidity"))
+			data, err := ec.unmarshalOGroupGrantInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐGroupGrantInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GroupValidity = data
+		case "permissions":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("permissions"))
+			data, err := ec.unmarshalNPermissionInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐPermissionInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Permissions = data
+		case "fieldFilters":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fieldFilters"))
+			data, err := ec.unmarshalOFieldFilterInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FieldFilters = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputFieldFilterInput(ctx context.Context, obj any) (model.FieldFilterInput, error) {
+	var it model.FieldFilterInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"field", "includeList", "excludeList", "filterType", "validFrom", "validUntil"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "field":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Field = data
+		case "includeList":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeList"))
+			data, err := ec.unmarshalNString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IncludeList = data
+		case "excludeList":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("excludeList"))
+			data, err := ec.unmarshalNString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExcludeList = data
+		case "filterType":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filterType"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FilterType = data
+		case "validFrom":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("validFrom"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ValidFrom = data
+		case "validUntil":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("validUntil"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ValidUntil = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputFilterRange(ctx context.Context, obj any) (model.FilterRange, error) {
+	var it model.FilterRange
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"gt", "gte", "lt", "lte"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "gt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gt"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Gt = data
+		case "gte":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gte"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Gte = data
+		case "lt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lt"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Lt = data
+		case "lte":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lte"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Lte = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputGroupGrantInput(ctx context.Context, obj any) (model.GroupGrantInput, error) {
+	var it model.GroupGrantInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"group", "validFrom", "validUntil"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "group":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("group"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Group = data
+		case "validFrom":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("validFrom"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ValidFrom = data
+		case "validUntil":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("validUntil"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ValidUntil = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputLoanCashFlowAggregateFilter(ctx context.Context, obj any) (model.LoanCashFlowAggregateFilter, error) {
+	var it model.LoanCashFlowAggregateFilter
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"loanCode", "startDate", "endDate", "glPeriodStart", "glPeriodEnd", "where"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "loanCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("loanCode"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.LoanCode = data
+		case "startDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("startDate"))
+			data, err := ec.unmarshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StartDate = data
+		case "endDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("endDate"))
+			data, err := ec.unmarshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.EndDate = data
+		case "glPeriodStart":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("glPeriodStart"))
+			data, err := ec.unmarshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GlPeriodStart = data
+		case "glPeriodEnd":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("glPeriodEnd"))
+			data, err := ec.unmarshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GlPeriodEnd = data
+		case "where":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("where"))
+			data, err := ec.unmarshalOLoanCashFlowFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐRowFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Where = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputLoanCashFlowFilter(ctx context.Context, obj any) (model.RowFilterInput, error) {
+	var it model.RowFilterInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"field", "eq", "in", "range", "and", "or"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "field":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Field = data
+		case "eq":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("eq"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Eq = data
+		case "in":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("in"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.In = data
+		case "range":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("range"))
+			data, err := ec.unmarshalOFilterRange2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFilterRange(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Range = data
+		case "and":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("and"))
+			data, err := ec.unmarshalOLoanCashFlowFilter2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐRowFilterInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.And = data
+		case "or":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("or"))
+			data, err := ec.unmarshalOLoanCashFlowFilter2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐRowFilterInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Or = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputLoanCashFlowMetricInput(ctx context.Context, obj any) (model.LoanCashFlowMetricInput, error) {
+	var it model.LoanCashFlowMetricInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"field", "function"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "field":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Field = data
+		case "function":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("function"))
+			data, err := ec.unmarshalNAggregateFunction2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐAggregateFunction(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Function = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputLoanCashFlowSort(ctx context.Context, obj any) (model.LoanCashFlowSort, error) {
+	var it model.LoanCashFlowSort
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["order"]; !present {
+		asMap["order"] = "ASC"
+	}
+
+	fieldsInOrder := [...]string{"field", "order"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "field":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Field = data
+		case "order":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+			data, err := ec.unmarshalOSortOrder2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐSortOrder(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Order = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputLoanInfoFilter(ctx context.Context, obj any) (model.RowFilterInput, error) {
+	var it model.RowFilterInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"field", "eq", "in", "range", "and", "or"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "field":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Field = data
+		case "eq":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("eq"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Eq = data
+		case "in":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("in"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.In = data
+		case "range":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("range"))
+			data, err := ec.unmarshalOFilterRange2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFilterRange(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Range = data
+		case "and":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("and"))
+			data, err := ec.unmarshalOLoanInfoFilter2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐRowFilterInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.And = data
+		case "or":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("or"))
+			data, err := ec.unmarshalOLoanInfoFilter2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐRowFilterInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Or = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputMergeStrategyInput(ctx context.Context, obj any) (model.MergeStrategyInput, error) {
+	var it model.MergeStrategyInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"field", "strategy"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "field":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Field = data
+		case "strategy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("strategy"))
+			data, err := ec.unmarshalNFieldFilterMergeStrategy2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterMergeStrategy(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Strategy = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPermissionInput(ctx context.Context, obj any) (model.PermissionInput, error) {
+	var it model.PermissionInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"table", "column", "action", "validFrom", "validUntil"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "table":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("table"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Table = data
+		case "column":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("column"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Column = data
+		case "action":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("action"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Action = data
+		case "validFrom":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("validFrom"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ValidFrom = data
+		case "validUntil":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("validUntil"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ValidUntil = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateGroupACLInput(ctx context.Context, obj any) (model.UpdateGroupACLInput, error) {
+	var it model.UpdateGroupACLInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"groupName", "groups", "groupValidity", "permissions", "fieldFilters", "mergeStrategies", "expectedVersion", "expectedUpdatedAt"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "groupName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groupName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GroupName = data
+		case "groups":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groups"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Groups = data
+		case "groupValidity":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groupValidity"))
+			data, err := ec.unmarshalOGroupGrantInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐGroupGrantInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GroupValidity = data
+		case "permissions":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("permissions"))
+			data, err := ec.unmarshalNPermissionInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐPermissionInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Permissions = data
+		case "fieldFilters":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fieldFilters"))
+			data, err := ec.unmarshalOFieldFilterInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FieldFilters = data
+		case "mergeStrategies":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mergeStrategies"))
+			data, err := ec.unmarshalOMergeStrategyInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐMergeStrategyInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MergeStrategies = data
+		case "expectedVersion":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExpectedVersion = data
+		case "expectedUpdatedAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedUpdatedAt"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExpectedUpdatedAt = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateUserACLInput(ctx context.Context, obj any) (model.UpdateUserACLInput, error) {
+	var it model.UpdateUserACLInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"email", "groups", "groupValidity", "permissions", "fieldFilters", "expectedVersion", "expectedUpdatedAt"}
+	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
-		switch k {
-		case "groupName":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groupName"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		switch k {
+		case "email":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Email = data
+		case "groups":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groups"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Groups = data
+		case "groupValidity":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groupValidity"))
+			data, err := ec.unmarshalOGroupGrantInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐGroupGrantInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GroupValidity = data
+		case "permissions":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("permissions"))
+			data, err := ec.unmarshalOPermissionInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐPermissionInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Permissions = data
+		case "fieldFilters":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fieldFilters"))
+			data, err := ec.unmarshalOFieldFilterInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FieldFilters = data
+		case "expectedVersion":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExpectedVersion = data
+		case "expectedUpdatedAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedUpdatedAt"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExpectedUpdatedAt = data
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var aCLAuditChangeImplementors = []string{"ACLAuditChange"}
+
+func (ec *executionContext) _ACLAuditChange(ctx context.Context, sel ast.SelectionSet, obj *model.ACLAuditChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aCLAuditChangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ACLAuditChange")
+		case "path":
+			out.Values[i] = ec._ACLAuditChange_path(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "before":
+			out.Values[i] = ec._ACLAuditChange_before(ctx, field, obj)
+		case "after":
+			out.Values[i] = ec._ACLAuditChange_after(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var aCLAuditEntryImplementors = []string{"ACLAuditEntry"}
+
+func (ec *executionContext) _ACLAuditEntry(ctx context.Context, sel ast.SelectionSet, obj *model.ACLAuditEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aCLAuditEntryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ACLAuditEntry")
+		case "entryID":
+			out.Values[i] = ec._ACLAuditEntry_entryID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "principalID":
+			out.Values[i] = ec._ACLAuditEntry_principalID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "actor":
+			out.Values[i] = ec._ACLAuditEntry_actor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._ACLAuditEntry_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "operation":
+			out.Values[i] = ec._ACLAuditEntry_operation(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "version":
+			out.Values[i] = ec._ACLAuditEntry_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "changes":
+			out.Values[i] = ec._ACLAuditEntry_changes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var aCLExportImplementors = []string{"ACLExport"}
+
+func (ec *executionContext) _ACLExport(ctx context.Context, sel ast.SelectionSet, obj *model.ACLExport) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aCLExportImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ACLExport")
+		case "format":
+			out.Values[i] = ec._ACLExport_format(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "filename":
+			out.Values[i] = ec._ACLExport_filename(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "contentType":
+			out.Values[i] = ec._ACLExport_contentType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "content":
+			out.Values[i] = ec._ACLExport_content(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rowCount":
+			out.Values[i] = ec._ACLExport_rowCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var aCLImportResultImplementors = []string{"ACLImportResult"}
+
+func (ec *executionContext) _ACLImportResult(ctx context.Context, sel ast.SelectionSet, obj *model.ACLImportResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aCLImportResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ACLImportResult")
+		case "success":
+			out.Values[i] = ec._ACLImportResult_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "applied":
+			out.Values[i] = ec._ACLImportResult_applied(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._ACLImportResult_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rows":
+			out.Values[i] = ec._ACLImportResult_rows(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var aCLImportRowResultImplementors = []string{"ACLImportRowResult"}
+
+func (ec *executionContext) _ACLImportRowResult(ctx context.Context, sel ast.SelectionSet, obj *model.ACLImportRowResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aCLImportRowResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ACLImportRowResult")
+		case "line":
+			out.Values[i] = ec._ACLImportRowResult_line(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "principalID":
+			out.Values[i] = ec._ACLImportRowResult_principalID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._ACLImportRowResult_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._ACLImportRowResult_message(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var aCLMutationResultImplementors = []string{"ACLMutationResult"}
+
+func (ec *executionContext) _ACLMutationResult(ctx context.Context, sel ast.SelectionSet, obj *model.ACLMutationResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aCLMutationResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ACLMutationResult")
+		case "success":
+			out.Values[i] = ec._ACLMutationResult_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._ACLMutationResult_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "conflict":
+			out.Values[i] = ec._ACLMutationResult_conflict(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "record":
+			out.Values[i] = ec._ACLMutationResult_record(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var aCLRecordImplementors = []string{"ACLRecord"}
+
+func (ec *executionContext) _ACLRecord(ctx context.Context, sel ast.SelectionSet, obj *model.ACLRecord) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aCLRecordImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ACLRecord")
+		case "principalID":
+			out.Values[i] = ec._ACLRecord_principalID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "groups":
+			out.Values[i] = ec._ACLRecord_groups(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "permissions":
+			out.Values[i] = ec._ACLRecord_permissions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fieldFilters":
+			out.Values[i] = ec._ACLRecord_fieldFilters(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "mergeStrategies":
+			out.Values[i] = ec._ACLRecord_mergeStrategies(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "groupValidity":
+			out.Values[i] = ec._ACLRecord_groupValidity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._ACLRecord_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "version":
+			out.Values[i] = ec._ACLRecord_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var aCLRecordVersionImplementors = []string{"ACLRecordVersion"}
+
+func (ec *executionContext) _ACLRecordVersion(ctx context.Context, sel ast.SelectionSet, obj *model.ACLRecordVersion) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aCLRecordVersionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ACLRecordVersion")
+		case "principalID":
+			out.Values[i] = ec._ACLRecordVersion_principalID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "version":
+			out.Values[i] = ec._ACLRecordVersion_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "record":
+			out.Values[i] = ec._ACLRecordVersion_record(ctx, field, obj)
+		case "actor":
+			out.Values[i] = ec._ACLRecordVersion_actor(ctx, field, obj)
+		case "operation":
+			out.Values[i] = ec._ACLRecordVersion_operation(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._ACLRecordVersion_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var expiringGrantImplementors = []string{"ExpiringGrant"}
+
+func (ec *executionContext) _ExpiringGrant(ctx context.Context, sel ast.SelectionSet, obj *model.ExpiringGrant) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, expiringGrantImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ExpiringGrant")
+		case "principalID":
+			out.Values[i] = ec._ExpiringGrant_principalID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "kind":
+			out.Values[i] = ec._ExpiringGrant_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._ExpiringGrant_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "validUntil":
+			out.Values[i] = ec._ExpiringGrant_validUntil(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var fieldFilterImplementors = []string{"FieldFilter"}
+
+func (ec *executionContext) _FieldFilter(ctx context.Context, sel ast.SelectionSet, obj *model.FieldFilter) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fieldFilterImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FieldFilter")
+		case "field":
+			out.Values[i] = ec._FieldFilter_field(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "includeList":
+			out.Values[i] = ec._FieldFilter_includeList(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "excludeList":
+			out.Values[i] = ec._FieldFilter_excludeList(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "filterType":
+			out.Values[i] = ec._FieldFilter_filterType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "validFrom":
+			out.Values[i] = ec._FieldFilter_validFrom(ctx, field, obj)
+		case "validUntil":
+			out.Values[i] = ec._FieldFilter_validUntil(ctx, field, obj)
+		case "allOf":
+			out.Values[i] = ec._FieldFilter_allOf(ctx, field, obj)
+		case "anyOf":
+			out.Values[i] = ec._FieldFilter_anyOf(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var groupGrantImplementors = []string{"GroupGrant"}
+
+func (ec *executionContext) _GroupGrant(ctx context.Context, sel ast.SelectionSet, obj *model.GroupGrant) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, groupGrantImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GroupGrant")
+		case "group":
+			out.Values[i] = ec._GroupGrant_group(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "validFrom":
+			out.Values[i] = ec._GroupGrant_validFrom(ctx, field, obj)
+		case "validUntil":
+			out.Values[i] = ec._GroupGrant_validUntil(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var loanCashFlowImplementors = []string{"LoanCashFlow"}
+
+func (ec *executionContext) _LoanCashFlow(ctx context.Context, sel ast.SelectionSet, obj *model.LoanCashFlow) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, loanCashFlowImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LoanCashFlow")
+		case "loanCode":
+			out.Values[i] = ec._LoanCashFlow_loanCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "maxHmy":
+			out.Values[i] = ec._LoanCashFlow_maxHmy(ctx, field, obj)
+		case "accrualEndDate":
+			out.Values[i] = ec._LoanCashFlow_accrualEndDate(ctx, field, obj)
+		case "accrualStartDate":
+			out.Values[i] = ec._LoanCashFlow_accrualStartDate(ctx, field, obj)
+		case "balance":
+			out.Values[i] = ec._LoanCashFlow_balance(ctx, field, obj)
+		case "capitalizedFee":
+			out.Values[i] = ec._LoanCashFlow_capitalizedFee(ctx, field, obj)
+		case "capitalizedInterest":
+			out.Values[i] = ec._LoanCashFlow_capitalizedInterest(ctx, field, obj)
+		case "capitalizedLoanAdministrationFee":
+			out.Values[i] = ec._LoanCashFlow_capitalizedLoanAdministrationFee(ctx, field, obj)
+		case "capitalizedOtherFees":
+			out.Values[i] = ec._LoanCashFlow_capitalizedOtherFees(ctx, field, obj)
+		case "commitment":
+			out.Values[i] = ec._LoanCashFlow_commitment(ctx, field, obj)
+		case "drawActualPrincipal":
+			out.Values[i] = ec._LoanCashFlow_drawActualPrincipal(ctx, field, obj)
+		case "eBalance":
+			out.Values[i] = ec._LoanCashFlow_eBalance(ctx, field, obj)
+		case "glPeriodDate":
+			out.Values[i] = ec._LoanCashFlow_glPeriodDate(ctx, field, obj)
+		case "interest":
+			out.Values[i] = ec._LoanCashFlow_interest(ctx, field, obj)
+		case "leverageActivity":
+			out.Values[i] = ec._LoanCashFlow_leverageActivity(ctx, field, obj)
+		case "leverageBalance":
+			out.Values[i] = ec._LoanCashFlow_leverageBalance(ctx, field, obj)
+		case "leverageInterest":
+			out.Values[i] = ec._LoanCashFlow_leverageInterest(ctx, field, obj)
+		case "loanDesc":
+			out.Values[i] = ec._LoanCashFlow_loanDesc(ctx, field, obj)
+		case "paymentNumber":
+			out.Values[i] = ec._LoanCashFlow_paymentNumber(ctx, field, obj)
+		case "postDate":
+			out.Values[i] = ec._LoanCashFlow_postDate(ctx, field, obj)
+		case "propertyCode":
+			out.Values[i] = ec._LoanCashFlow_propertyCode(ctx, field, obj)
+		case "propertyName":
+			out.Values[i] = ec._LoanCashFlow_propertyName(ctx, field, obj)
+		case "sBalance":
+			out.Values[i] = ec._LoanCashFlow_sBalance(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._LoanCashFlow_status(ctx, field, obj)
+		case "loanInfo":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LoanCashFlow_loanInfo(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var loanCashFlowAggregateGroupImplementors = []string{"LoanCashFlowAggregateGroup"}
+
+func (ec *executionContext) _LoanCashFlowAggregateGroup(ctx context.Context, sel ast.SelectionSet, obj *model.LoanCashFlowAggregateGroup) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, loanCashFlowAggregateGroupImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LoanCashFlowAggregateGroup")
+		case "key":
+			out.Values[i] = ec._LoanCashFlowAggregateGroup_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "count":
+			out.Values[i] = ec._LoanCashFlowAggregateGroup_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "metrics":
+			out.Values[i] = ec._LoanCashFlowAggregateGroup_metrics(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var loanCashFlowConnectionImplementors = []string{"LoanCashFlowConnection"}
+
+func (ec *executionContext) _LoanCashFlowConnection(ctx context.Context, sel ast.SelectionSet, obj *model.LoanCashFlowConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, loanCashFlowConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LoanCashFlowConnection")
+		case "edges":
+			out.Values[i] = ec._LoanCashFlowConnection_edges(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pageInfo":
+			out.Values[i] = ec._LoanCashFlowConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var loanCashFlowEdgeImplementors = []string{"LoanCashFlowEdge"}
+
+func (ec *executionContext) _LoanCashFlowEdge(ctx context.Context, sel ast.SelectionSet, obj *model.LoanCashFlowEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, loanCashFlowEdgeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LoanCashFlowEdge")
+		case "cursor":
+			out.Values[i] = ec._LoanCashFlowEdge_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "node":
+			out.Values[i] = ec._LoanCashFlowEdge_node(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var loanCashFlowGroupKeyImplementors = []string{"LoanCashFlowGroupKey"}
+
+func (ec *executionContext) _LoanCashFlowGroupKey(ctx context.Context, sel ast.SelectionSet, obj *model.LoanCashFlowGroupKey) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, loanCashFlowGroupKeyImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LoanCashFlowGroupKey")
+		case "field":
+			out.Values[i] = ec._LoanCashFlowGroupKey_field(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "value":
+			out.Values[i] = ec._LoanCashFlowGroupKey_value(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var loanCashFlowMetricValueImplementors = []string{"LoanCashFlowMetricValue"}
+
+func (ec *executionContext) _LoanCashFlowMetricValue(ctx context.Context, sel ast.SelectionSet, obj *model.LoanCashFlowMetricValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, loanCashFlowMetricValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LoanCashFlowMetricValue")
+		case "field":
+			out.Values[i] = ec._LoanCashFlowMetricValue_field(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "function":
+			out.Values[i] = ec._LoanCashFlowMetricValue_function(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "value":
+			out.Values[i] = ec._LoanCashFlowMetricValue_value(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var loanCashFlowsImplementors = []string{"LoanCashFlows"}
+
+func (ec *executionContext) _LoanCashFlows(ctx context.Context, sel ast.SelectionSet, obj *model.LoanCashFlows) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, loanCashFlowsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LoanCashFlows")
+		case "byLoanCode":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LoanCashFlows_byLoanCode(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "byLoanCodeConnection":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LoanCashFlows_byLoanCodeConnection(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var loanInfoImplementors = []string{"LoanInfo"}
+
+func (ec *executionContext) _LoanInfo(ctx context.Context, sel ast.SelectionSet, obj *model.LoanInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, loanInfoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LoanInfo")
+		case "loanCode":
+			out.Values[i] = ec._LoanInfo_loanCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "loanName":
+			out.Values[i] = ec._LoanInfo_loanName(ctx, field, obj)
+		case "loanStatus":
+			out.Values[i] = ec._LoanInfo_loanStatus(ctx, field, obj)
+		case "loanType":
+			out.Values[i] = ec._LoanInfo_loanType(ctx, field, obj)
+		case "borrower":
+			out.Values[i] = ec._LoanInfo_borrower(ctx, field, obj)
+		case "lender":
+			out.Values[i] = ec._LoanInfo_lender(ctx, field, obj)
+		case "fund":
+			out.Values[i] = ec._LoanInfo_fund(ctx, field, obj)
+		case "propertyCode":
+			out.Values[i] = ec._LoanInfo_propertyCode(ctx, field, obj)
+		case "propertyName":
+			out.Values[i] = ec._LoanInfo_propertyName(ctx, field, obj)
+		case "originationDate":
+			out.Values[i] = ec._LoanInfo_originationDate(ctx, field, obj)
+		case "maturityDate":
+			out.Values[i] = ec._LoanInfo_maturityDate(ctx, field, obj)
+		case "commitmentAmount":
+			out.Values[i] = ec._LoanInfo_commitmentAmount(ctx, field, obj)
+		case "interestRate":
+			out.Values[i] = ec._LoanInfo_interestRate(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var mergeStrategyImplementors = []string{"MergeStrategy"}
+
+func (ec *executionContext) _MergeStrategy(ctx context.Context, sel ast.SelectionSet, obj *model.MergeStrategy) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mergeStrategyImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MergeStrategy")
+		case "field":
+			out.Values[i] = ec._MergeStrategy_field(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "strategy":
+			out.Values[i] = ec._MergeStrategy_strategy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "addUserACL":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_addUserACL(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateUserACL":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateUserACL(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "addGroupACL":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_addGroupACL(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateGroupACL":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateGroupACL(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteUserACL":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteUserACL(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteGroupACL":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteGroupACL(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rollbackACL":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_rollbackACL(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "importACL":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_importACL(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var pageInfoImplementors = []string{"PageInfo"}
+
+func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *model.PageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PageInfo")
+		case "hasNextPage":
+			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hasPreviousPage":
+			out.Values[i] = ec._PageInfo_hasPreviousPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "startCursor":
+			out.Values[i] = ec._PageInfo_startCursor(ctx, field, obj)
+		case "endCursor":
+			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var permissionImplementors = []string{"Permission"}
+
+func (ec *executionContext) _Permission(ctx context.Context, sel ast.SelectionSet, obj *model.Permission) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, permissionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Permission")
+		case "table":
+			out.Values[i] = ec._Permission_table(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "column":
+			out.Values[i] = ec._Permission_column(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "action":
+			out.Values[i] = ec._Permission_action(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "validFrom":
+			out.Values[i] = ec._Permission_validFrom(ctx, field, obj)
+		case "validUntil":
+			out.Values[i] = ec._Permission_validUntil(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var propertiesImplementors = []string{"Properties"}
+
+func (ec *executionContext) _Properties(ctx context.Context, sel ast.SelectionSet, obj *model.Properties) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, propertiesImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Properties")
+		case "byPropertyCode":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Properties_byPropertyCode(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var propertyImplementors = []string{"Property"}
+
+func (ec *executionContext) _Property(ctx context.Context, sel ast.SelectionSet, obj *model.Property) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, propertyImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Property")
+		case "propertyCode":
+			out.Values[i] = ec._Property_propertyCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "propertyName":
+			out.Values[i] = ec._Property_propertyName(ctx, field, obj)
+		case "loans":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Property_loans(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			it.GroupName = data
-		case "permissions":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("permissions"))
-			data, err := ec.unmarshalNPermissionInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐPermissionInputᚄ(ctx, v)
-			if err != nil {
-				return it, err
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			it.Permissions = data
-		case "fieldFilters":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fieldFilters"))
-			data, err := ec.unmarshalOFieldFilterInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterInputᚄ(ctx, v)
-			if err != nil {
-				return it, err
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "cashFlows":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Property_cashFlows(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			it.FieldFilters = data
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputAddUserACLInput(ctx context.Context, obj any) (model.AddUserACLInput, error) {
-	var it model.AddUserACLInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"email", "groups", "permissions", "fieldFilters"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "email":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var queryImplementors = []string{"Query"}
+
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "loanCashFlow":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_loanCashFlow(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			it.Email = data
-		case "groups":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groups"))
-			data, err := ec.unmarshalNString2ᚕstringᚄ(ctx, v)
-			if err != nil {
-				return it, err
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-			it.Groups = data
-		case "permissions":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("permissions"))
-			data, err := ec.unmarshalNPermissionInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐPermissionInputᚄ(ctx, v)
-			if err != nil {
-				return it, err
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "loanCashFlowAggregate":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_loanCashFlowAggregate(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			it.Permissions = data
-		case "fieldFilters":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fieldFilters"))
-			data, err := ec.unmarshalOFieldFilterInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterInputᚄ(ctx, v)
-			if err != nil {
-				return it, err
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-			it.FieldFilters = data
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "loanInfo":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_loanInfo(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "property":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_property(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "ssotReportsAdministratorConfiguration":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_ssotReportsAdministratorConfiguration(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "__type":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___type(ctx, field)
+			})
+		case "__schema":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___schema(ctx, field)
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputFieldFilterInput(ctx context.Context, obj any) (model.FieldFilterInput, error) {
-	var it model.FieldFilterInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"field", "includeList", "excludeList", "filterType"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "field":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Field = data
-		case "includeList":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeList"))
-			data, err := ec.unmarshalNString2ᚕstringᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.IncludeList = data
-		case "excludeList":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("excludeList"))
-			data, err := ec.unmarshalNString2ᚕstringᚄ(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var ssotReportsAdministratorConfigurationImplementors = []string{"SsotReportsAdministratorConfiguration"}
+
+func (ec *executionContext) _SsotReportsAdministratorConfiguration(ctx context.Context, sel ast.SelectionSet, obj *model.SsotReportsAdministratorConfiguration) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, ssotReportsAdministratorConfigurationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SsotReportsAdministratorConfiguration")
+		case "listACLRecords":
+			out.Values[i] = ec._SsotReportsAdministratorConfiguration_listACLRecords(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			it.ExcludeList = data
-		case "filterType":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filterType"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "expiringGrants":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SsotReportsAdministratorConfiguration_expiringGrants(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			it.FilterType = data
-		}
-	}
 
-	return it, nil
-}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) unmarshalInputPermissionInput(ctx context.Context, obj any) (model.PermissionInput, error) {
-	var it model.PermissionInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
 
-	fieldsInOrder := [...]string{"table", "action"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "table":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("table"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "aclAuditLog":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SsotReportsAdministratorConfiguration_aclAuditLog(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			it.Table = data
-		case "action":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("action"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			it.Action = data
-		}
-	}
 
-	return it, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "aclRecordHistory":
+			field := field
 
-func (ec *executionContext) unmarshalInputUpdateGroupACLInput(ctx context.Context, obj any) (model.UpdateGroupACLInput, error) {
-	var it model.UpdateGroupACLInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SsotReportsAdministratorConfiguration_aclRecordHistory(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-	fieldsInOrder := [...]string{"groupName", "permissions", "fieldFilters"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "groupName":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groupName"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			it.GroupName = data
-		case "permissions":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("permissions"))
-			data, err := ec.unmarshalNPermissionInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐPermissionInputᚄ(ctx, v)
-			if err != nil {
-				return it, err
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "exportACL":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SsotReportsAdministratorConfiguration_exportACL(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			it.Permissions = data
-		case "fieldFilters":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fieldFilters"))
-			data, err := ec.unmarshalOFieldFilterInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterInputᚄ(ctx, v)
-			if err != nil {
-				return it, err
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			it.FieldFilters = data
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputUpdateUserACLInput(ctx context.Context, obj any) (model.UpdateUserACLInput, error) {
-	var it model.UpdateUserACLInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"email", "groups", "permissions", "fieldFilters"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "email":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var __DirectiveImplementors = []string{"__Directive"}
+
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Email = data
-		case "groups":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groups"))
-			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
-			if err != nil {
-				return it, err
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "isRepeatable":
+			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Groups = data
-		case "permissions":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("permissions"))
-			data, err := ec.unmarshalOPermissionInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐPermissionInputᚄ(ctx, v)
-			if err != nil {
-				return it, err
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Permissions = data
-		case "fieldFilters":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fieldFilters"))
-			data, err := ec.unmarshalOFieldFilterInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterInputᚄ(ctx, v)
-			if err != nil {
-				return it, err
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.FieldFilters = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
-
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-// endregion ************************** interface.gotpl ***************************
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-// region    **************************** object.gotpl ****************************
+	return out
+}
 
-var aCLMutationResultImplementors = []string{"ACLMutationResult"}
+var __EnumValueImplementors = []string{"__EnumValue"}
 
-func (ec *executionContext) _ACLMutationResult(ctx context.Context, sel ast.SelectionSet, obj *model.ACLMutationResult) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, aCLMutationResultImplementors)
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ACLMutationResult")
-		case "success":
-			out.Values[i] = ec._ACLMutationResult_success(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "message":
-			out.Values[i] = ec._ACLMutationResult_message(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "record":
-			out.Values[i] = ec._ACLMutationResult_record(ctx, field, obj)
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -4256,42 +11162,41 @@ func (ec *executionContext) _ACLMutationResult(ctx context.Context, sel ast.Sele
 	return out
 }
 
-var aCLRecordImplementors = []string{"ACLRecord"}
+var __FieldImplementors = []string{"__Field"}
 
-func (ec *executionContext) _ACLRecord(ctx context.Context, sel ast.SelectionSet, obj *model.ACLRecord) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, aCLRecordImplementors)
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ACLRecord")
-		case "principalID":
-			out.Values[i] = ec._ACLRecord_principalID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "groups":
-			out.Values[i] = ec._ACLRecord_groups(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "permissions":
-			out.Values[i] = ec._ACLRecord_permissions(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "fieldFilters":
-			out.Values[i] = ec._ACLRecord_fieldFilters(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "updatedAt":
-			out.Values[i] = ec._ACLRecord_updatedAt(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -4315,37 +11220,38 @@ func (ec *executionContext) _ACLRecord(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var fieldFilterImplementors = []string{"FieldFilter"}
+var __InputValueImplementors = []string{"__InputValue"}
 
-func (ec *executionContext) _FieldFilter(ctx context.Context, sel ast.SelectionSet, obj *model.FieldFilter) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, fieldFilterImplementors)
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("FieldFilter")
-		case "field":
-			out.Values[i] = ec._FieldFilter_field(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "includeList":
-			out.Values[i] = ec._FieldFilter_includeList(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "excludeList":
-			out.Values[i] = ec._FieldFilter_excludeList(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "filterType":
-			out.Values[i] = ec._FieldFilter_filterType(ctx, field, obj)
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "deprecationReason":
+			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -4369,68 +11275,38 @@ func (ec *executionContext) _FieldFilter(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var loanCashFlowImplementors = []string{"LoanCashFlow"}
+var __SchemaImplementors = []string{"__Schema"}
 
-func (ec *executionContext) _LoanCashFlow(ctx context.Context, sel ast.SelectionSet, obj *model.LoanCashFlow) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, loanCashFlowImplementors)
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("LoanCashFlow")
-		case "loanCode":
-			out.Values[i] = ec._LoanCashFlow_loanCode(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "description":
+			out.Values[i] = ec.___Schema_description(ctx, field, obj)
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "maxHmy":
-			out.Values[i] = ec._LoanCashFlow_maxHmy(ctx, field, obj)
-		case "accrualEndDate":
-			out.Values[i] = ec._LoanCashFlow_accrualEndDate(ctx, field, obj)
-		case "accrualStartDate":
-			out.Values[i] = ec._LoanCashFlow_accrualStartDate(ctx, field, obj)
-		case "balance":
-			out.Values[i] = ec._LoanCashFlow_balance(ctx, field, obj)
-		case "capitalizedFee":
-			out.Values[i] = ec._LoanCashFlow_capitalizedFee(ctx, field, obj)
-		case "capitalizedInterest":
-			out.Values[i] = ec._LoanCashFlow_capitalizedInterest(ctx, field, obj)
-		case "capitalizedLoanAdministrationFee":
-			out.Values[i] = ec._LoanCashFlow_capitalizedLoanAdministrationFee(ctx, field, obj)
-		case "capitalizedOtherFees":
-			out.Values[i] = ec._LoanCashFlow_capitalizedOtherFees(ctx, field, obj)
-		case "commitment":
-			out.Values[i] = ec._LoanCashFlow_commitment(ctx, field, obj)
-		case "drawActualPrincipal":
-			out.Values[i] = ec._LoanCashFlow_drawActualPrincipal(ctx, field, obj)
-		case "eBalance":
-			out.Values[i] = ec._LoanCashFlow_eBalance(ctx, field, obj)
-		case "glPeriodDate":
-			out.Values[i] = ec._LoanCashFlow_glPeriodDate(ctx, field, obj)
-		case "interest":
-			out.Values[i] = ec._LoanCashFlow_interest(ctx, field, obj)
-		case "leverageActivity":
-			out.Values[i] = ec._LoanCashFlow_leverageActivity(ctx, field, obj)
-		case "leverageBalance":
-			out.Values[i] = ec._LoanCashFlow_leverageBalance(ctx, field, obj)
-		case "leverageInterest":
-			out.Values[i] = ec._LoanCashFlow_leverageInterest(ctx, field, obj)
-		case "loanDesc":
-			out.Values[i] = ec._LoanCashFlow_loanDesc(ctx, field, obj)
-		case "paymentNumber":
-			out.Values[i] = ec._LoanCashFlow_paymentNumber(ctx, field, obj)
-		case "postDate":
-			out.Values[i] = ec._LoanCashFlow_postDate(ctx, field, obj)
-		case "propertyCode":
-			out.Values[i] = ec._LoanCashFlow_propertyCode(ctx, field, obj)
-		case "propertyName":
-			out.Values[i] = ec._LoanCashFlow_propertyName(ctx, field, obj)
-		case "sBalance":
-			out.Values[i] = ec._LoanCashFlow_sBalance(ctx, field, obj)
-		case "status":
-			out.Values[i] = ec._LoanCashFlow_status(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -4454,53 +11330,42 @@ func (ec *executionContext) _LoanCashFlow(ctx context.Context, sel ast.Selection
 	return out
 }
 
-var loanCashFlowsImplementors = []string{"LoanCashFlows"}
+var __TypeImplementors = []string{"__Type"}
 
-func (ec *executionContext) _LoanCashFlows(ctx context.Context, sel ast.SelectionSet, obj *model.LoanCashFlows) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, loanCashFlowsImplementors)
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("LoanCashFlows")
-		case "byLoanCode":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._LoanCashFlows_byLoanCode(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "specifiedByURL":
+			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+		case "isOneOf":
+			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -4524,617 +11389,862 @@ func (ec *executionContext) _LoanCashFlows(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var mutationImplementors = []string{"Mutation"}
-
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
-	})
+// endregion **************************** object.gotpl ****************************
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
+// region    ***************************** type.gotpl *****************************
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "addUserACL":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_addUserACL(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "updateUserACL":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateUserACL(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "addGroupACL":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_addGroupACL(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "updateGroupACL":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateGroupACL(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deleteUserACL":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteUserACL(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNACLAuditChange2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLAuditChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ACLAuditChange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deleteGroupACL":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteGroupACL(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+			ret[i] = ec.marshalNACLAuditChange2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLAuditChange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNACLAuditChange2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLAuditChange(ctx context.Context, sel ast.SelectionSet, v *model.ACLAuditChange) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ACLAuditChange(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNACLAuditEntry2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLAuditEntryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ACLAuditEntry) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNACLAuditEntry2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLAuditEntry(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNACLAuditEntry2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLAuditEntry(ctx context.Context, sel ast.SelectionSet, v *model.ACLAuditEntry) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._ACLAuditEntry(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNACLAuditOperation2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLAuditOperation(ctx context.Context, v any) (model.ACLAuditOperation, error) {
+	var res model.ACLAuditOperation
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNACLAuditOperation2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLAuditOperation(ctx context.Context, sel ast.SelectionSet, v model.ACLAuditOperation) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNACLExport2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLExport(ctx context.Context, sel ast.SelectionSet, v model.ACLExport) graphql.Marshaler {
+	return ec._ACLExport(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNACLExport2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLExport(ctx context.Context, sel ast.SelectionSet, v *model.ACLExport) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._ACLExport(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) unmarshalNACLFileFormat2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLFileFormat(ctx context.Context, v any) (model.ACLFileFormat, error) {
+	var res model.ACLFileFormat
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var permissionImplementors = []string{"Permission"}
+func (ec *executionContext) marshalNACLFileFormat2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLFileFormat(ctx context.Context, sel ast.SelectionSet, v model.ACLFileFormat) graphql.Marshaler {
+	return v
+}
 
-func (ec *executionContext) _Permission(ctx context.Context, sel ast.SelectionSet, obj *model.Permission) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, permissionImplementors)
+func (ec *executionContext) unmarshalNACLImportMode2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLImportMode(ctx context.Context, v any) (model.ACLImportMode, error) {
+	var res model.ACLImportMode
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Permission")
-		case "table":
-			out.Values[i] = ec._Permission_table(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "action":
-			out.Values[i] = ec._Permission_action(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNACLImportMode2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLImportMode(ctx context.Context, sel ast.SelectionSet, v model.ACLImportMode) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNACLImportResult2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLImportResult(ctx context.Context, sel ast.SelectionSet, v model.ACLImportResult) graphql.Marshaler {
+	return ec._ACLImportResult(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNACLImportResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLImportResult(ctx context.Context, sel ast.SelectionSet, v *model.ACLImportResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._ACLImportResult(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNACLImportRowResult2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLImportRowResultᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ACLImportRowResult) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNACLImportRowResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLImportRowResult(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
 	}
+	wg.Wait()
 
-	return out
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-var queryImplementors = []string{"Query"}
+func (ec *executionContext) marshalNACLImportRowResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLImportRowResult(ctx context.Context, sel ast.SelectionSet, v *model.ACLImportRowResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ACLImportRowResult(ctx, sel, v)
+}
 
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+func (ec *executionContext) unmarshalNACLImportRowStatus2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLImportRowStatus(ctx context.Context, v any) (model.ACLImportRowStatus, error) {
+	var res model.ACLImportRowStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
+func (ec *executionContext) marshalNACLImportRowStatus2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLImportRowStatus(ctx context.Context, sel ast.SelectionSet, v model.ACLImportRowStatus) graphql.Marshaler {
+	return v
+}
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "loanCashFlow":
-			field := field
+func (ec *executionContext) marshalNACLMutationResult2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLMutationResult(ctx context.Context, sel ast.SelectionSet, v model.ACLMutationResult) graphql.Marshaler {
+	return ec._ACLMutationResult(ctx, sel, &v)
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_loanCashFlow(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
+func (ec *executionContext) marshalNACLMutationResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLMutationResult(ctx context.Context, sel ast.SelectionSet, v *model.ACLMutationResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ACLMutationResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNACLRecord2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLRecordᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ACLRecord) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNACLRecord2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLRecord(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	}
+	wg.Wait()
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "ssotReportsAdministratorConfiguration":
-			field := field
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_ssotReportsAdministratorConfiguration(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
+	return ret
+}
+
+func (ec *executionContext) marshalNACLRecord2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLRecord(ctx context.Context, sel ast.SelectionSet, v *model.ACLRecord) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ACLRecord(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNACLRecordVersion2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLRecordVersionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ACLRecordVersion) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNACLRecordVersion2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLRecordVersion(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	}
+	wg.Wait()
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "__type":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___type(ctx, field)
-			})
-		case "__schema":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___schema(ctx, field)
-			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNACLRecordVersion2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLRecordVersion(ctx context.Context, sel ast.SelectionSet, v *model.ACLRecordVersion) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._ACLRecordVersion(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNAddGroupACLInput2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐAddGroupACLInput(ctx context.Context, v any) (model.AddGroupACLInput, error) {
+	res, err := ec.unmarshalInputAddGroupACLInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+func (ec *executionContext) unmarshalNAddUserACLInput2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐAddUserACLInput(ctx context.Context, v any) (model.AddUserACLInput, error) {
+	res, err := ec.unmarshalInputAddUserACLInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	return out
+func (ec *executionContext) unmarshalNAggregateFunction2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐAggregateFunction(ctx context.Context, v any) (model.AggregateFunction, error) {
+	var res model.AggregateFunction
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var ssotReportsAdministratorConfigurationImplementors = []string{"SsotReportsAdministratorConfiguration"}
+func (ec *executionContext) marshalNAggregateFunction2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐAggregateFunction(ctx context.Context, sel ast.SelectionSet, v model.AggregateFunction) graphql.Marshaler {
+	return v
+}
 
-func (ec *executionContext) _SsotReportsAdministratorConfiguration(ctx context.Context, sel ast.SelectionSet, obj *model.SsotReportsAdministratorConfiguration) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, ssotReportsAdministratorConfigurationImplementors)
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("SsotReportsAdministratorConfiguration")
-		case "listACLRecords":
-			out.Values[i] = ec._SsotReportsAdministratorConfiguration_listACLRecords(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	return res
+}
+
+func (ec *executionContext) marshalNExpiringGrant2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐExpiringGrantᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ExpiringGrant) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNExpiringGrant2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐExpiringGrant(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
-
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+func (ec *executionContext) marshalNExpiringGrant2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐExpiringGrant(ctx context.Context, sel ast.SelectionSet, v *model.ExpiringGrant) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ExpiringGrant(ctx, sel, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-		case "isRepeatable":
-			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNFieldFilter2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.FieldFilter) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNFieldFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilter(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNFieldFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilter(ctx context.Context, sel ast.SelectionSet, v *model.FieldFilter) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._FieldFilter(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) unmarshalNFieldFilterInput2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterInput(ctx context.Context, v any) (*model.FieldFilterInput, error) {
+	res, err := ec.unmarshalInputFieldFilterInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
+func (ec *executionContext) unmarshalNFieldFilterMergeStrategy2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterMergeStrategy(ctx context.Context, v any) (model.FieldFilterMergeStrategy, error) {
+	var res model.FieldFilterMergeStrategy
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+func (ec *executionContext) marshalNFieldFilterMergeStrategy2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterMergeStrategy(ctx context.Context, sel ast.SelectionSet, v model.FieldFilterMergeStrategy) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) unmarshalNGrantKind2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐGrantKind(ctx context.Context, v any) (model.GrantKind, error) {
+	var res model.GrantKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNGrantKind2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐGrantKind(ctx context.Context, sel ast.SelectionSet, v model.GrantKind) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNGroupGrant2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐGroupGrantᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.GroupGrant) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNGroupGrant2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐGroupGrant(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNGroupGrant2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐGroupGrant(ctx context.Context, sel ast.SelectionSet, v *model.GroupGrant) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._GroupGrant(ctx, sel, v)
 }
 
-var __FieldImplementors = []string{"__Field"}
+func (ec *executionContext) unmarshalNGroupGrantInput2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐGroupGrantInput(ctx context.Context, v any) (*model.GroupGrantInput, error) {
+	res, err := ec.unmarshalInputGroupGrantInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+func (ec *executionContext) unmarshalNInt2int32(ctx context.Context, v any) (int32, error) {
+	res, err := graphql.UnmarshalInt32(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNInt2int32(ctx context.Context, sel ast.SelectionSet, v int32) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalInt32(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	return res
+}
+
+func (ec *executionContext) marshalNLoanCashFlow2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.LoanCashFlow) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLoanCashFlow2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlow(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
-
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNLoanCashFlow2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlow(ctx context.Context, sel ast.SelectionSet, v *model.LoanCashFlow) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._LoanCashFlow(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNLoanCashFlowAggregateGroup2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowAggregateGroupᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.LoanCashFlowAggregateGroup) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLoanCashFlowAggregateGroup2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowAggregateGroup(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
 	}
+	wg.Wait()
 
-	return out
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-var __SchemaImplementors = []string{"__Schema"}
+func (ec *executionContext) marshalNLoanCashFlowAggregateGroup2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowAggregateGroup(ctx context.Context, sel ast.SelectionSet, v *model.LoanCashFlowAggregateGroup) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LoanCashFlowAggregateGroup(ctx, sel, v)
+}
 
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+func (ec *executionContext) marshalNLoanCashFlowConnection2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowConnection(ctx context.Context, sel ast.SelectionSet, v model.LoanCashFlowConnection) graphql.Marshaler {
+	return ec._LoanCashFlowConnection(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "description":
-			out.Values[i] = ec.___Schema_description(ctx, field, obj)
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNLoanCashFlowConnection2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowConnection(ctx context.Context, sel ast.SelectionSet, v *model.LoanCashFlowConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._LoanCashFlowConnection(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNLoanCashFlowEdge2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.LoanCashFlowEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLoanCashFlowEdge2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	return out
-}
+	}
+	wg.Wait()
 
-var __TypeImplementors = []string{"__Type"}
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+	return ret
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-		case "specifiedByURL":
-			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-		case "isOneOf":
-			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNLoanCashFlowEdge2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowEdge(ctx context.Context, sel ast.SelectionSet, v *model.LoanCashFlowEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._LoanCashFlowEdge(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNLoanCashFlowFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐRowFilterInput(ctx context.Context, v any) (*model.RowFilterInput, error) {
+	res, err := ec.unmarshalInputLoanCashFlowFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNLoanCashFlowGroupKey2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowGroupKeyᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.LoanCashFlowGroupKey) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLoanCashFlowGroupKey2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowGroupKey(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	return out
-}
-
-// endregion **************************** object.gotpl ****************************
+	}
+	wg.Wait()
 
-// region    ***************************** type.gotpl *****************************
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-func (ec *executionContext) marshalNACLMutationResult2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLMutationResult(ctx context.Context, sel ast.SelectionSet, v model.ACLMutationResult) graphql.Marshaler {
-	return ec._ACLMutationResult(ctx, sel, &v)
+	return ret
 }
 
-func (ec *executionContext) marshalNACLMutationResult2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLMutationResult(ctx context.Context, sel ast.SelectionSet, v *model.ACLMutationResult) graphql.Marshaler {
+func (ec *executionContext) marshalNLoanCashFlowGroupKey2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowGroupKey(ctx context.Context, sel ast.SelectionSet, v *model.LoanCashFlowGroupKey) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ACLMutationResult(ctx, sel, v)
+	return ec._LoanCashFlowGroupKey(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNACLRecord2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLRecordᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ACLRecord) graphql.Marshaler {
+func (ec *executionContext) unmarshalNLoanCashFlowMetricInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowMetricInputᚄ(ctx context.Context, v any) ([]*model.LoanCashFlowMetricInput, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.LoanCashFlowMetricInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNLoanCashFlowMetricInput2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowMetricInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNLoanCashFlowMetricInput2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowMetricInput(ctx context.Context, v any) (*model.LoanCashFlowMetricInput, error) {
+	res, err := ec.unmarshalInputLoanCashFlowMetricInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNLoanCashFlowMetricValue2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowMetricValueᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.LoanCashFlowMetricValue) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -5158,7 +12268,7 @@ func (ec *executionContext) marshalNACLRecord2ᚕᚖssotᚋgqlᚋgraphqlᚋgraph
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNACLRecord2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLRecord(ctx, sel, v[i])
+			ret[i] = ec.marshalNLoanCashFlowMetricValue2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowMetricValue(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -5178,43 +12288,36 @@ func (ec *executionContext) marshalNACLRecord2ᚕᚖssotᚋgqlᚋgraphqlᚋgraph
 	return ret
 }
 
-func (ec *executionContext) marshalNACLRecord2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLRecord(ctx context.Context, sel ast.SelectionSet, v *model.ACLRecord) graphql.Marshaler {
+func (ec *executionContext) marshalNLoanCashFlowMetricValue2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowMetricValue(ctx context.Context, sel ast.SelectionSet, v *model.LoanCashFlowMetricValue) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ACLRecord(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNAddGroupACLInput2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐAddGroupACLInput(ctx context.Context, v any) (model.AddGroupACLInput, error) {
-	res, err := ec.unmarshalInputAddGroupACLInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ec._LoanCashFlowMetricValue(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNAddUserACLInput2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐAddUserACLInput(ctx context.Context, v any) (model.AddUserACLInput, error) {
-	res, err := ec.unmarshalInputAddUserACLInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) unmarshalNLoanCashFlowSort2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowSort(ctx context.Context, v any) (*model.LoanCashFlowSort, error) {
+	res, err := ec.unmarshalInputLoanCashFlowSort(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNLoanCashFlows2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlows(ctx context.Context, sel ast.SelectionSet, v model.LoanCashFlows) graphql.Marshaler {
+	return ec._LoanCashFlows(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNLoanCashFlows2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlows(ctx context.Context, sel ast.SelectionSet, v *model.LoanCashFlows) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._LoanCashFlows(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNFieldFilter2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.FieldFilter) graphql.Marshaler {
+func (ec *executionContext) marshalNLoanInfo2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanInfoᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.LoanInfo) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -5238,7 +12341,7 @@ func (ec *executionContext) marshalNFieldFilter2ᚕᚖssotᚋgqlᚋgraphqlᚋgra
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNFieldFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilter(ctx, sel, v[i])
+			ret[i] = ec.marshalNLoanInfo2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanInfo(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -5258,22 +12361,22 @@ func (ec *executionContext) marshalNFieldFilter2ᚕᚖssotᚋgqlᚋgraphqlᚋgra
 	return ret
 }
 
-func (ec *executionContext) marshalNFieldFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilter(ctx context.Context, sel ast.SelectionSet, v *model.FieldFilter) graphql.Marshaler {
+func (ec *executionContext) marshalNLoanInfo2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanInfo(ctx context.Context, sel ast.SelectionSet, v *model.LoanInfo) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._FieldFilter(ctx, sel, v)
+	return ec._LoanInfo(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNFieldFilterInput2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterInput(ctx context.Context, v any) (*model.FieldFilterInput, error) {
-	res, err := ec.unmarshalInputFieldFilterInput(ctx, v)
+func (ec *executionContext) unmarshalNLoanInfoFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐRowFilterInput(ctx context.Context, v any) (*model.RowFilterInput, error) {
+	res, err := ec.unmarshalInputLoanInfoFilter(ctx, v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNLoanCashFlow2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.LoanCashFlow) graphql.Marshaler {
+func (ec *executionContext) marshalNMergeStrategy2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐMergeStrategyᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.MergeStrategy) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -5297,7 +12400,7 @@ func (ec *executionContext) marshalNLoanCashFlow2ᚕᚖssotᚋgqlᚋgraphqlᚋgr
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNLoanCashFlow2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlow(ctx, sel, v[i])
+			ret[i] = ec.marshalNMergeStrategy2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐMergeStrategy(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -5317,28 +12420,29 @@ func (ec *executionContext) marshalNLoanCashFlow2ᚕᚖssotᚋgqlᚋgraphqlᚋgr
 	return ret
 }
 
-func (ec *executionContext) marshalNLoanCashFlow2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlow(ctx context.Context, sel ast.SelectionSet, v *model.LoanCashFlow) graphql.Marshaler {
+func (ec *executionContext) marshalNMergeStrategy2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐMergeStrategy(ctx context.Context, sel ast.SelectionSet, v *model.MergeStrategy) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._LoanCashFlow(ctx, sel, v)
+	return ec._MergeStrategy(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNLoanCashFlows2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlows(ctx context.Context, sel ast.SelectionSet, v model.LoanCashFlows) graphql.Marshaler {
-	return ec._LoanCashFlows(ctx, sel, &v)
+func (ec *executionContext) unmarshalNMergeStrategyInput2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐMergeStrategyInput(ctx context.Context, v any) (*model.MergeStrategyInput, error) {
+	res, err := ec.unmarshalInputMergeStrategyInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNLoanCashFlows2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlows(ctx context.Context, sel ast.SelectionSet, v *model.LoanCashFlows) graphql.Marshaler {
+func (ec *executionContext) marshalNPageInfo2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *model.PageInfo) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._LoanCashFlows(ctx, sel, v)
+	return ec._PageInfo(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalNPermission2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐPermissionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Permission) graphql.Marshaler {
@@ -5407,12 +12511,80 @@ func (ec *executionContext) unmarshalNPermissionInput2ᚕᚖssotᚋgqlᚋgraphql
 			return nil, err
 		}
 	}
-	return res, nil
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNPermissionInput2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐPermissionInput(ctx context.Context, v any) (*model.PermissionInput, error) {
+	res, err := ec.unmarshalInputPermissionInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNProperties2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐProperties(ctx context.Context, sel ast.SelectionSet, v model.Properties) graphql.Marshaler {
+	return ec._Properties(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNProperties2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐProperties(ctx context.Context, sel ast.SelectionSet, v *model.Properties) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Properties(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNProperty2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐPropertyᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Property) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNProperty2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐProperty(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) unmarshalNPermissionInput2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐPermissionInput(ctx context.Context, v any) (*model.PermissionInput, error) {
-	res, err := ec.unmarshalInputPermissionInput(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNProperty2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐProperty(ctx context.Context, sel ast.SelectionSet, v *model.Property) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Property(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalNSsotReportsAdministratorConfiguration2ssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐSsotReportsAdministratorConfiguration(ctx context.Context, sel ast.SelectionSet, v model.SsotReportsAdministratorConfiguration) graphql.Marshaler {
@@ -5509,6 +12681,22 @@ func (ec *executionContext) unmarshalNUpdateUserACLInput2ssotᚋgqlᚋgraphqlᚋ
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNUpload2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚐUpload(ctx context.Context, v any) (graphql.Upload, error) {
+	res, err := graphql.UnmarshalUpload(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNUpload2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚐUpload(ctx context.Context, sel ast.SelectionSet, v graphql.Upload) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalUpload(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
 	return ec.___Directive(ctx, sel, &v)
 }
@@ -5762,6 +12950,46 @@ func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel a
 	return res
 }
 
+func (ec *executionContext) unmarshalOACLAuditFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLAuditFilter(ctx context.Context, v any) (*model.ACLAuditFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputACLAuditFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOACLAuditOperation2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLAuditOperation(ctx context.Context, v any) (*model.ACLAuditOperation, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.ACLAuditOperation)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOACLAuditOperation2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLAuditOperation(ctx context.Context, sel ast.SelectionSet, v *model.ACLAuditOperation) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOACLFileFormat2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLFileFormat(ctx context.Context, v any) (*model.ACLFileFormat, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.ACLFileFormat)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOACLFileFormat2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLFileFormat(ctx context.Context, sel ast.SelectionSet, v *model.ACLFileFormat) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalOACLRecord2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐACLRecord(ctx context.Context, sel ast.SelectionSet, v *model.ACLRecord) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -5799,6 +13027,101 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return res
 }
 
+func (ec *executionContext) unmarshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate(ctx context.Context, v any) (*model.Date, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.Date)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalODate2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDate(ctx context.Context, sel ast.SelectionSet, v *model.Date) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalODateTime2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDateTime(ctx context.Context, v any) (*model.DateTime, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.DateTime)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalODateTime2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDateTime(ctx context.Context, sel ast.SelectionSet, v *model.DateTime) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalODecimal2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDecimal(ctx context.Context, v any) (*model.Decimal, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.Decimal)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalODecimal2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐDecimal(ctx context.Context, sel ast.SelectionSet, v *model.Decimal) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOFieldFilter2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.FieldFilter) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNFieldFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilter(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOFieldFilterInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFieldFilterInputᚄ(ctx context.Context, v any) ([]*model.FieldFilterInput, error) {
 	if v == nil {
 		return nil, nil
@@ -5817,21 +13140,151 @@ func (ec *executionContext) unmarshalOFieldFilterInput2ᚕᚖssotᚋgqlᚋgraphq
 	return res, nil
 }
 
-func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v any) (*float64, error) {
+func (ec *executionContext) unmarshalOFilterRange2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐFilterRange(ctx context.Context, v any) (*model.FilterRange, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputFilterRange(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOGroupGrantInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐGroupGrantInputᚄ(ctx context.Context, v any) ([]*model.GroupGrantInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.GroupGrantInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNGroupGrantInput2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐGroupGrantInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalOInt2ᚖint32(ctx context.Context, v any) (*int32, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	res, err := graphql.UnmarshalInt32(v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel ast.SelectionSet, v *float64) graphql.Marshaler {
+func (ec *executionContext) marshalOInt2ᚖint32(ctx context.Context, sel ast.SelectionSet, v *int32) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	_ = sel
-	res := graphql.MarshalFloatContext(*v)
-	return graphql.WrapContextMarshaler(ctx, res)
+	_ = ctx
+	res := graphql.MarshalInt32(*v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOLoanCashFlowAggregateFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowAggregateFilter(ctx context.Context, v any) (*model.LoanCashFlowAggregateFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputLoanCashFlowAggregateFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOLoanCashFlowFilter2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐRowFilterInputᚄ(ctx context.Context, v any) ([]*model.RowFilterInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.RowFilterInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNLoanCashFlowFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐRowFilterInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalOLoanCashFlowFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐRowFilterInput(ctx context.Context, v any) (*model.RowFilterInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputLoanCashFlowFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOLoanCashFlowSort2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowSortᚄ(ctx context.Context, v any) ([]*model.LoanCashFlowSort, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.LoanCashFlowSort, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNLoanCashFlowSort2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanCashFlowSort(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOLoanInfo2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐLoanInfo(ctx context.Context, sel ast.SelectionSet, v *model.LoanInfo) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._LoanInfo(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOLoanInfoFilter2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐRowFilterInputᚄ(ctx context.Context, v any) ([]*model.RowFilterInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.RowFilterInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNLoanInfoFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐRowFilterInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalOLoanInfoFilter2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐRowFilterInput(ctx context.Context, v any) (*model.RowFilterInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputLoanInfoFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOMergeStrategyInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐMergeStrategyInputᚄ(ctx context.Context, v any) ([]*model.MergeStrategyInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.MergeStrategyInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNMergeStrategyInput2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐMergeStrategyInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
 func (ec *executionContext) unmarshalOPermissionInput2ᚕᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐPermissionInputᚄ(ctx context.Context, v any) ([]*model.PermissionInput, error) {
@@ -5852,6 +13305,22 @@ func (ec *executionContext) unmarshalOPermissionInput2ᚕᚖssotᚋgqlᚋgraphql
 	return res, nil
 }
 
+func (ec *executionContext) unmarshalOSortOrder2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐSortOrder(ctx context.Context, v any) (*model.SortOrder, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.SortOrder)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOSortOrder2ᚖssotᚋgqlᚋgraphqlᚋgraphᚋmodelᚐSortOrder(ctx context.Context, sel ast.SelectionSet, v *model.SortOrder) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
 	if v == nil {
 		return nil, nil
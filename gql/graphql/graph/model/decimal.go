@@ -0,0 +1,189 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact base-10 number: unscaled * 10^-scale. Monetary columns use it so values
+// read from DynamoDB reach the client, and sum up, without binary floating point rounding.
+// The zero value is 0. Decimals are immutable; every operation returns a new value.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+// maxDecimalExponent bounds exponents in input; DynamoDB numbers stay within 10^±130
+const maxDecimalExponent = 400
+
+var bigTen = big.NewInt(10)
+
+// ParseDecimal reads a decimal number such as "-1234.50" or "1.5E+3"
+func ParseDecimal(value string) (Decimal, error) {
+	text := strings.TrimSpace(value)
+
+	exponent := 0
+	if i := strings.IndexAny(text, "eE"); i >= 0 {
+		parsed, err := strconv.Atoi(text[i+1:])
+		if err != nil || parsed < -maxDecimalExponent || parsed > maxDecimalExponent {
+			return Decimal{}, fmt.Errorf("invalid Decimal %q", value)
+		}
+		exponent = parsed
+		text = text[:i]
+	}
+
+	digits := text
+	scale := 0
+	if i := strings.IndexByte(text, '.'); i >= 0 {
+		digits = text[:i] + text[i+1:]
+		scale = len(text) - i - 1
+	}
+
+	unscaled, ok := new(big.Int).SetString(digits, 10)
+	if !ok || strings.ContainsAny(digits, "_") {
+		return Decimal{}, fmt.Errorf("invalid Decimal %q", value)
+	}
+
+	scale -= exponent
+	if scale < 0 {
+		unscaled.Mul(unscaled, new(big.Int).Exp(bigTen, big.NewInt(int64(-scale)), nil))
+		scale = 0
+	}
+
+	return Decimal{unscaled: unscaled, scale: int32(scale)}.normalize(), nil
+}
+
+// NewDecimalFromInt returns the decimal for an integer
+func NewDecimalFromInt(value int64) Decimal {
+	return Decimal{unscaled: big.NewInt(value)}
+}
+
+func (d Decimal) coefficient() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// rescale returns the coefficient of d at a scale at least as large as d's own
+func (d Decimal) rescale(scale int32) *big.Int {
+	shifted := new(big.Int).Set(d.coefficient())
+	if scale > d.scale {
+		shifted.Mul(shifted, new(big.Int).Exp(bigTen, big.NewInt(int64(scale-d.scale)), nil))
+	}
+	return shifted
+}
+
+// normalize drops trailing fractional zeros so equal values print the same
+func (d Decimal) normalize() Decimal {
+	unscaled := new(big.Int).Set(d.coefficient())
+	scale := d.scale
+	remainder := new(big.Int)
+	for scale > 0 {
+		quotient, mod := new(big.Int).QuoRem(unscaled, bigTen, remainder)
+		if mod.Sign() != 0 {
+			break
+		}
+		unscaled = quotient
+		scale--
+	}
+	return Decimal{unscaled: unscaled, scale: scale}
+}
+
+// Add returns d + other
+func (d Decimal) Add(other Decimal) Decimal {
+	scale := max(d.scale, other.scale)
+	sum := new(big.Int).Add(d.rescale(scale), other.rescale(scale))
+	return Decimal{unscaled: sum, scale: scale}.normalize()
+}
+
+// Cmp compares d and other, returning -1, 0 or +1
+func (d Decimal) Cmp(other Decimal) int {
+	scale := max(d.scale, other.scale)
+	return d.rescale(scale).Cmp(other.rescale(scale))
+}
+
+// DivInt returns d / divisor rounded half away from zero to the given number of fractional digits
+func (d Decimal) DivInt(divisor int64, digits int32) Decimal {
+	if divisor == 0 {
+		panic("model: Decimal division by zero")
+	}
+
+	scale := max(d.scale, digits)
+	// One extra digit decides the rounding
+	numerator := d.rescale(scale + 1)
+	quotient := new(big.Int).Quo(numerator, big.NewInt(divisor))
+
+	lastDigit := new(big.Int).Rem(quotient, bigTen)
+	quotient.Quo(quotient, bigTen)
+	if lastDigit.CmpAbs(big.NewInt(5)) >= 0 {
+		if lastDigit.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+
+	return Decimal{unscaled: quotient, scale: scale}.normalize()
+}
+
+// String formats the decimal in plain notation, e.g. "-1234.5"
+func (d Decimal) String() string {
+	coefficient := d.coefficient()
+	digits := new(big.Int).Abs(coefficient).String()
+
+	if d.scale > 0 {
+		if pad := int(d.scale) - len(digits) + 1; pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		split := len(digits) - int(d.scale)
+		digits = digits[:split] + "." + digits[split:]
+	}
+
+	if coefficient.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// Float64 returns the nearest float64, for callers that only need an approximation
+func (d Decimal) Float64() float64 {
+	value, _ := strconv.ParseFloat(d.String(), 64)
+	return value
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface. Strings are preferred, since
+// JSON numbers may already have been rounded by the client.
+func (d *Decimal) UnmarshalGQL(v any) error {
+	var text string
+	switch value := v.(type) {
+	case string:
+		text = value
+	case json.Number:
+		text = value.String()
+	case int:
+		text = strconv.Itoa(value)
+	case int64:
+		text = strconv.FormatInt(value, 10)
+	case float64:
+		text = strconv.FormatFloat(value, 'f', -1, 64)
+	default:
+		return fmt.Errorf("Decimal must be a string or number")
+	}
+
+	parsed, err := ParseDecimal(text)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalGQL implements the graphql.Marshaler interface, writing the exact digits as a JSON number
+func (d Decimal) MarshalGQL(w io.Writer) {
+	io.WriteString(w, d.String())
+}
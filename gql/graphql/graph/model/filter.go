@@ -0,0 +1,12 @@
+package model
+
+// RowFilterInput is a condition on the rows of a dataset. The LoanCashFlowFilter and
+// LoanInfoFilter inputs share it, so one validator handles every dataset.
+type RowFilterInput struct {
+	Field *string           `json:"field,omitempty"`
+	Eq    *string           `json:"eq,omitempty"`
+	In    []string          `json:"in,omitempty"`
+	Range *FilterRange      `json:"range,omitempty"`
+	And   []*RowFilterInput `json:"and,omitempty"`
+	Or    []*RowFilterInput `json:"or,omitempty"`
+}
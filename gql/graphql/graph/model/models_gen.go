@@ -2,38 +2,119 @@
 
 package model
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type ACLAuditChange struct {
+	Path   string  `json:"path"`
+	Before *string `json:"before,omitempty"`
+	After  *string `json:"after,omitempty"`
+}
+
+type ACLAuditEntry struct {
+	EntryID     string            `json:"entryID"`
+	PrincipalID string            `json:"principalID"`
+	Actor       string            `json:"actor"`
+	Timestamp   string            `json:"timestamp"`
+	Operation   ACLAuditOperation `json:"operation"`
+	Version     int32             `json:"version"`
+	Changes     []*ACLAuditChange `json:"changes"`
+}
+
+type ACLAuditFilter struct {
+	PrincipalID *string            `json:"principalID,omitempty"`
+	Actor       *string            `json:"actor,omitempty"`
+	Operation   *ACLAuditOperation `json:"operation,omitempty"`
+	From        *string            `json:"from,omitempty"`
+	Until       *string            `json:"until,omitempty"`
+}
+
+type ACLExport struct {
+	Format      ACLFileFormat `json:"format"`
+	Filename    string        `json:"filename"`
+	ContentType string        `json:"contentType"`
+	Content     string        `json:"content"`
+	RowCount    int32         `json:"rowCount"`
+}
+
+type ACLImportResult struct {
+	Success bool                  `json:"success"`
+	Applied bool                  `json:"applied"`
+	Message string                `json:"message"`
+	Rows    []*ACLImportRowResult `json:"rows"`
+}
+
+type ACLImportRowResult struct {
+	Line        int32              `json:"line"`
+	PrincipalID string             `json:"principalID"`
+	Status      ACLImportRowStatus `json:"status"`
+	Message     *string            `json:"message,omitempty"`
+}
+
 type ACLMutationResult struct {
-	Success bool       `json:"success"`
-	Message string     `json:"message"`
-	Record  *ACLRecord `json:"record,omitempty"`
+	Success  bool       `json:"success"`
+	Message  string     `json:"message"`
+	Conflict bool       `json:"conflict"`
+	Record   *ACLRecord `json:"record,omitempty"`
 }
 
 type ACLRecord struct {
-	PrincipalID  string         `json:"principalID"`
-	Groups       []string       `json:"groups"`
-	Permissions  []*Permission  `json:"permissions"`
-	FieldFilters []*FieldFilter `json:"fieldFilters"`
-	UpdatedAt    string         `json:"updatedAt"`
+	PrincipalID     string           `json:"principalID"`
+	Groups          []string         `json:"groups"`
+	Permissions     []*Permission    `json:"permissions"`
+	FieldFilters    []*FieldFilter   `json:"fieldFilters"`
+	MergeStrategies []*MergeStrategy `json:"mergeStrategies"`
+	GroupValidity   []*GroupGrant    `json:"groupValidity"`
+	UpdatedAt       string           `json:"updatedAt"`
+	Version         int32            `json:"version"`
+}
+
+type ACLRecordVersion struct {
+	PrincipalID string             `json:"principalID"`
+	Version     int32              `json:"version"`
+	Record      *ACLRecord         `json:"record,omitempty"`
+	Actor       *string            `json:"actor,omitempty"`
+	Operation   *ACLAuditOperation `json:"operation,omitempty"`
+	CreatedAt   string             `json:"createdAt"`
 }
 
 type AddGroupACLInput struct {
-	GroupName    string              `json:"groupName"`
-	Permissions  []*PermissionInput  `json:"permissions"`
-	FieldFilters []*FieldFilterInput `json:"fieldFilters,omitempty"`
+	GroupName       string                `json:"groupName"`
+	Groups          []string              `json:"groups,omitempty"`
+	GroupValidity   []*GroupGrantInput    `json:"groupValidity,omitempty"`
+	Permissions     []*PermissionInput    `json:"permissions"`
+	FieldFilters    []*FieldFilterInput   `json:"fieldFilters,omitempty"`
+	MergeStrategies []*MergeStrategyInput `json:"mergeStrategies,omitempty"`
 }
 
 type AddUserACLInput struct {
-	Email        string              `json:"email"`
-	Groups       []string            `json:"groups"`
-	Permissions  []*PermissionInput  `json:"permissions"`
-	FieldFilters []*FieldFilterInput `json:"fieldFilters,omitempty"`
+	Email         string              `json:"email"`
+	Groups        []string            `json:"groups"`
+	GroupValidity []*GroupGrantInput  `json:"groupValidity,omitempty"`
+	Permissions   []*PermissionInput  `json:"permissions"`
+	FieldFilters  []*FieldFilterInput `json:"fieldFilters,omitempty"`
+}
+
+type ExpiringGrant struct {
+	PrincipalID string    `json:"principalID"`
+	Kind        GrantKind `json:"kind"`
+	Name        string    `json:"name"`
+	ValidUntil  string    `json:"validUntil"`
 }
 
 type FieldFilter struct {
-	Field       string   `json:"field"`
-	IncludeList []string `json:"includeList"`
-	ExcludeList []string `json:"excludeList"`
-	FilterType  string   `json:"filterType"`
+	Field       string         `json:"field"`
+	IncludeList []string       `json:"includeList"`
+	ExcludeList []string       `json:"excludeList"`
+	FilterType  string         `json:"filterType"`
+	ValidFrom   *string        `json:"validFrom,omitempty"`
+	ValidUntil  *string        `json:"validUntil,omitempty"`
+	AllOf       []*FieldFilter `json:"allOf,omitempty"`
+	AnyOf       []*FieldFilter `json:"anyOf,omitempty"`
 }
 
 type FieldFilterInput struct {
@@ -41,68 +122,674 @@ type FieldFilterInput struct {
 	IncludeList []string `json:"includeList"`
 	ExcludeList []string `json:"excludeList"`
 	FilterType  string   `json:"filterType"`
+	ValidFrom   *string  `json:"validFrom,omitempty"`
+	ValidUntil  *string  `json:"validUntil,omitempty"`
+}
+
+type FilterRange struct {
+	Gt  *string `json:"gt,omitempty"`
+	Gte *string `json:"gte,omitempty"`
+	Lt  *string `json:"lt,omitempty"`
+	Lte *string `json:"lte,omitempty"`
+}
+
+type GroupGrant struct {
+	Group      string  `json:"group"`
+	ValidFrom  *string `json:"validFrom,omitempty"`
+	ValidUntil *string `json:"validUntil,omitempty"`
+}
+
+type GroupGrantInput struct {
+	Group      string  `json:"group"`
+	ValidFrom  *string `json:"validFrom,omitempty"`
+	ValidUntil *string `json:"validUntil,omitempty"`
 }
 
 type LoanCashFlow struct {
-	LoanCode                         string   `json:"loanCode"`
-	MaxHmy                           *string  `json:"maxHmy,omitempty"`
-	AccrualEndDate                   *string  `json:"accrualEndDate,omitempty"`
-	AccrualStartDate                 *string  `json:"accrualStartDate,omitempty"`
-	Balance                          *float64 `json:"balance,omitempty"`
-	CapitalizedFee                   *float64 `json:"capitalizedFee,omitempty"`
-	CapitalizedInterest              *float64 `json:"capitalizedInterest,omitempty"`
-	CapitalizedLoanAdministrationFee *float64 `json:"capitalizedLoanAdministrationFee,omitempty"`
-	CapitalizedOtherFees             *float64 `json:"capitalizedOtherFees,omitempty"`
-	Commitment                       *float64 `json:"commitment,omitempty"`
-	DrawActualPrincipal              *float64 `json:"drawActualPrincipal,omitempty"`
-	EBalance                         *float64 `json:"eBalance,omitempty"`
-	GlPeriodDate                     *string  `json:"glPeriodDate,omitempty"`
-	Interest                         *float64 `json:"interest,omitempty"`
-	LeverageActivity                 *float64 `json:"leverageActivity,omitempty"`
-	LeverageBalance                  *float64 `json:"leverageBalance,omitempty"`
-	LeverageInterest                 *float64 `json:"leverageInterest,omitempty"`
-	LoanDesc                         *string  `json:"loanDesc,omitempty"`
-	PaymentNumber                    *string  `json:"paymentNumber,omitempty"`
-	PostDate                         *string  `json:"postDate,omitempty"`
-	PropertyCode                     *string  `json:"propertyCode,omitempty"`
-	PropertyName                     *string  `json:"propertyName,omitempty"`
-	SBalance                         *float64 `json:"sBalance,omitempty"`
-	Status                           *string  `json:"status,omitempty"`
+	LoanCode                         string    `json:"loanCode"`
+	MaxHmy                           *string   `json:"maxHmy,omitempty"`
+	AccrualEndDate                   *Date     `json:"accrualEndDate,omitempty"`
+	AccrualStartDate                 *Date     `json:"accrualStartDate,omitempty"`
+	Balance                          *Decimal  `json:"balance,omitempty"`
+	CapitalizedFee                   *Decimal  `json:"capitalizedFee,omitempty"`
+	CapitalizedInterest              *Decimal  `json:"capitalizedInterest,omitempty"`
+	CapitalizedLoanAdministrationFee *Decimal  `json:"capitalizedLoanAdministrationFee,omitempty"`
+	CapitalizedOtherFees             *Decimal  `json:"capitalizedOtherFees,omitempty"`
+	Commitment                       *Decimal  `json:"commitment,omitempty"`
+	DrawActualPrincipal              *Decimal  `json:"drawActualPrincipal,omitempty"`
+	EBalance                         *Decimal  `json:"eBalance,omitempty"`
+	GlPeriodDate                     *Date     `json:"glPeriodDate,omitempty"`
+	Interest                         *Decimal  `json:"interest,omitempty"`
+	LeverageActivity                 *Decimal  `json:"leverageActivity,omitempty"`
+	LeverageBalance                  *Decimal  `json:"leverageBalance,omitempty"`
+	LeverageInterest                 *Decimal  `json:"leverageInterest,omitempty"`
+	LoanDesc                         *string   `json:"loanDesc,omitempty"`
+	PaymentNumber                    *string   `json:"paymentNumber,omitempty"`
+	PostDate                         *DateTime `json:"postDate,omitempty"`
+	PropertyCode                     *string   `json:"propertyCode,omitempty"`
+	PropertyName                     *string   `json:"propertyName,omitempty"`
+	SBalance                         *Decimal  `json:"sBalance,omitempty"`
+	Status                           *string   `json:"status,omitempty"`
+	LoanInfo                         *LoanInfo `json:"loanInfo,omitempty"`
+}
+
+type LoanCashFlowAggregateFilter struct {
+	LoanCode      []string        `json:"loanCode,omitempty"`
+	StartDate     *Date           `json:"startDate,omitempty"`
+	EndDate       *Date           `json:"endDate,omitempty"`
+	GlPeriodStart *Date           `json:"glPeriodStart,omitempty"`
+	GlPeriodEnd   *Date           `json:"glPeriodEnd,omitempty"`
+	Where         *RowFilterInput `json:"where,omitempty"`
+}
+
+type LoanCashFlowAggregateGroup struct {
+	Key     []*LoanCashFlowGroupKey    `json:"key"`
+	Count   int32                      `json:"count"`
+	Metrics []*LoanCashFlowMetricValue `json:"metrics"`
+}
+
+type LoanCashFlowConnection struct {
+	Edges    []*LoanCashFlowEdge `json:"edges"`
+	PageInfo *PageInfo           `json:"pageInfo"`
+}
+
+type LoanCashFlowEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *LoanCashFlow `json:"node"`
+}
+
+type LoanCashFlowGroupKey struct {
+	Field string  `json:"field"`
+	Value *string `json:"value,omitempty"`
+}
+
+type LoanCashFlowMetricInput struct {
+	Field    string            `json:"field"`
+	Function AggregateFunction `json:"function"`
+}
+
+type LoanCashFlowMetricValue struct {
+	Field    string            `json:"field"`
+	Function AggregateFunction `json:"function"`
+	Value    *Decimal          `json:"value,omitempty"`
+}
+
+type LoanCashFlowSort struct {
+	Field string     `json:"field"`
+	Order *SortOrder `json:"order,omitempty"`
 }
 
 type LoanCashFlows struct {
-	ByLoanCode []*LoanCashFlow `json:"byLoanCode"`
+	ByLoanCode           []*LoanCashFlow         `json:"byLoanCode"`
+	ByLoanCodeConnection *LoanCashFlowConnection `json:"byLoanCodeConnection"`
+}
+
+type LoanInfo struct {
+	LoanCode         string   `json:"loanCode"`
+	LoanName         *string  `json:"loanName,omitempty"`
+	LoanStatus       *string  `json:"loanStatus,omitempty"`
+	LoanType         *string  `json:"loanType,omitempty"`
+	Borrower         *string  `json:"borrower,omitempty"`
+	Lender           *string  `json:"lender,omitempty"`
+	Fund             *string  `json:"fund,omitempty"`
+	PropertyCode     *string  `json:"propertyCode,omitempty"`
+	PropertyName     *string  `json:"propertyName,omitempty"`
+	OriginationDate  *Date    `json:"originationDate,omitempty"`
+	MaturityDate     *Date    `json:"maturityDate,omitempty"`
+	CommitmentAmount *Decimal `json:"commitmentAmount,omitempty"`
+	InterestRate     *Decimal `json:"interestRate,omitempty"`
+}
+
+type MergeStrategy struct {
+	Field    string                   `json:"field"`
+	Strategy FieldFilterMergeStrategy `json:"strategy"`
+}
+
+type MergeStrategyInput struct {
+	Field    string                   `json:"field"`
+	Strategy FieldFilterMergeStrategy `json:"strategy"`
 }
 
 type Mutation struct {
 }
 
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
+}
+
 type Permission struct {
-	Table  string `json:"table"`
-	Action string `json:"action"`
+	Table      string  `json:"table"`
+	Column     string  `json:"column"`
+	Action     string  `json:"action"`
+	ValidFrom  *string `json:"validFrom,omitempty"`
+	ValidUntil *string `json:"validUntil,omitempty"`
 }
 
 type PermissionInput struct {
-	Table  string `json:"table"`
-	Action string `json:"action"`
+	Table      string  `json:"table"`
+	Column     *string `json:"column,omitempty"`
+	Action     string  `json:"action"`
+	ValidFrom  *string `json:"validFrom,omitempty"`
+	ValidUntil *string `json:"validUntil,omitempty"`
+}
+
+type Properties struct {
+	ByPropertyCode []*Property `json:"byPropertyCode"`
 }
 
 type Query struct {
 }
 
 type SsotReportsAdministratorConfiguration struct {
-	ListACLRecords []*ACLRecord `json:"listACLRecords"`
+	ListACLRecords   []*ACLRecord        `json:"listACLRecords"`
+	ExpiringGrants   []*ExpiringGrant    `json:"expiringGrants"`
+	ACLAuditLog      []*ACLAuditEntry    `json:"aclAuditLog"`
+	ACLRecordHistory []*ACLRecordVersion `json:"aclRecordHistory"`
+	ExportACL        *ACLExport          `json:"exportACL"`
 }
 
 type UpdateGroupACLInput struct {
-	GroupName    string              `json:"groupName"`
-	Permissions  []*PermissionInput  `json:"permissions"`
-	FieldFilters []*FieldFilterInput `json:"fieldFilters,omitempty"`
+	GroupName         string                `json:"groupName"`
+	Groups            []string              `json:"groups,omitempty"`
+	GroupValidity     []*GroupGrantInput    `json:"groupValidity,omitempty"`
+	Permissions       []*PermissionInput    `json:"permissions"`
+	FieldFilters      []*FieldFilterInput   `json:"fieldFilters,omitempty"`
+	MergeStrategies   []*MergeStrategyInput `json:"mergeStrategies,omitempty"`
+	ExpectedVersion   *int32                `json:"expectedVersion,omitempty"`
+	ExpectedUpdatedAt *string               `json:"expectedUpdatedAt,omitempty"`
 }
 
 type UpdateUserACLInput struct {
-	Email        string              `json:"email"`
-	Groups       []string            `json:"groups,omitempty"`
-	Permissions  []*PermissionInput  `json:"permissions,omitempty"`
-	FieldFilters []*FieldFilterInput `json:"fieldFilters,omitempty"`
+	Email             string              `json:"email"`
+	Groups            []string            `json:"groups,omitempty"`
+	GroupValidity     []*GroupGrantInput  `json:"groupValidity,omitempty"`
+	Permissions       []*PermissionInput  `json:"permissions,omitempty"`
+	FieldFilters      []*FieldFilterInput `json:"fieldFilters,omitempty"`
+	ExpectedVersion   *int32              `json:"expectedVersion,omitempty"`
+	ExpectedUpdatedAt *string             `json:"expectedUpdatedAt,omitempty"`
+}
+
+type ACLAuditOperation string
+
+const (
+	ACLAuditOperationCreateUser                 ACLAuditOperation = "CREATE_USER"
+	ACLAuditOperationUpdateUserGroups           ACLAuditOperation = "UPDATE_USER_GROUPS"
+	ACLAuditOperationUpdateUserPermissions      ACLAuditOperation = "UPDATE_USER_PERMISSIONS"
+	ACLAuditOperationUpdateUser                 ACLAuditOperation = "UPDATE_USER"
+	ACLAuditOperationDeleteUser                 ACLAuditOperation = "DELETE_USER"
+	ACLAuditOperationCreateGroup                ACLAuditOperation = "CREATE_GROUP"
+	ACLAuditOperationUpdateGroupPermissions     ACLAuditOperation = "UPDATE_GROUP_PERMISSIONS"
+	ACLAuditOperationUpdateGroupParents         ACLAuditOperation = "UPDATE_GROUP_PARENTS"
+	ACLAuditOperationUpdateGroupMergeStrategies ACLAuditOperation = "UPDATE_GROUP_MERGE_STRATEGIES"
+	ACLAuditOperationUpdateGroup                ACLAuditOperation = "UPDATE_GROUP"
+	ACLAuditOperationDeleteGroup                ACLAuditOperation = "DELETE_GROUP"
+	ACLAuditOperationRollback                   ACLAuditOperation = "ROLLBACK"
+	ACLAuditOperationImport                     ACLAuditOperation = "IMPORT"
+)
+
+var AllACLAuditOperation = []ACLAuditOperation{
+	ACLAuditOperationCreateUser,
+	ACLAuditOperationUpdateUserGroups,
+	ACLAuditOperationUpdateUserPermissions,
+	ACLAuditOperationUpdateUser,
+	ACLAuditOperationDeleteUser,
+	ACLAuditOperationCreateGroup,
+	ACLAuditOperationUpdateGroupPermissions,
+	ACLAuditOperationUpdateGroupParents,
+	ACLAuditOperationUpdateGroupMergeStrategies,
+	ACLAuditOperationUpdateGroup,
+	ACLAuditOperationDeleteGroup,
+	ACLAuditOperationRollback,
+	ACLAuditOperationImport,
+}
+
+func (e ACLAuditOperation) IsValid() bool {
+	switch e {
+	case ACLAuditOperationCreateUser, ACLAuditOperationUpdateUserGroups, ACLAuditOperationUpdateUserPermissions, ACLAuditOperationUpdateUser, ACLAuditOperationDeleteUser, ACLAuditOperationCreateGroup, ACLAuditOperationUpdateGroupPermissions, ACLAuditOperationUpdateGroupParents, ACLAuditOperationUpdateGroupMergeStrategies, ACLAuditOperationUpdateGroup, ACLAuditOperationDeleteGroup, ACLAuditOperationRollback, ACLAuditOperationImport:
+		return true
+	}
+	return false
+}
+
+func (e ACLAuditOperation) String() string {
+	return string(e)
+}
+
+func (e *ACLAuditOperation) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ACLAuditOperation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ACLAuditOperation", str)
+	}
+	return nil
+}
+
+func (e ACLAuditOperation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ACLAuditOperation) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ACLAuditOperation) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ACLFileFormat string
+
+const (
+	ACLFileFormatJSON ACLFileFormat = "JSON"
+	ACLFileFormatCSV  ACLFileFormat = "CSV"
+	ACLFileFormatXlsx ACLFileFormat = "XLSX"
+)
+
+var AllACLFileFormat = []ACLFileFormat{
+	ACLFileFormatJSON,
+	ACLFileFormatCSV,
+	ACLFileFormatXlsx,
+}
+
+func (e ACLFileFormat) IsValid() bool {
+	switch e {
+	case ACLFileFormatJSON, ACLFileFormatCSV, ACLFileFormatXlsx:
+		return true
+	}
+	return false
+}
+
+func (e ACLFileFormat) String() string {
+	return string(e)
+}
+
+func (e *ACLFileFormat) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ACLFileFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ACLFileFormat", str)
+	}
+	return nil
+}
+
+func (e ACLFileFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ACLFileFormat) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ACLFileFormat) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ACLImportMode string
+
+const (
+	ACLImportModeMerge   ACLImportMode = "MERGE"
+	ACLImportModeReplace ACLImportMode = "REPLACE"
+)
+
+var AllACLImportMode = []ACLImportMode{
+	ACLImportModeMerge,
+	ACLImportModeReplace,
+}
+
+func (e ACLImportMode) IsValid() bool {
+	switch e {
+	case ACLImportModeMerge, ACLImportModeReplace:
+		return true
+	}
+	return false
+}
+
+func (e ACLImportMode) String() string {
+	return string(e)
+}
+
+func (e *ACLImportMode) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ACLImportMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ACLImportMode", str)
+	}
+	return nil
+}
+
+func (e ACLImportMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ACLImportMode) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ACLImportMode) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ACLImportRowStatus string
+
+const (
+	ACLImportRowStatusInvalid   ACLImportRowStatus = "INVALID"
+	ACLImportRowStatusCreate    ACLImportRowStatus = "CREATE"
+	ACLImportRowStatusUpdate    ACLImportRowStatus = "UPDATE"
+	ACLImportRowStatusUnchanged ACLImportRowStatus = "UNCHANGED"
+	ACLImportRowStatusFailed    ACLImportRowStatus = "FAILED"
+)
+
+var AllACLImportRowStatus = []ACLImportRowStatus{
+	ACLImportRowStatusInvalid,
+	ACLImportRowStatusCreate,
+	ACLImportRowStatusUpdate,
+	ACLImportRowStatusUnchanged,
+	ACLImportRowStatusFailed,
+}
+
+func (e ACLImportRowStatus) IsValid() bool {
+	switch e {
+	case ACLImportRowStatusInvalid, ACLImportRowStatusCreate, ACLImportRowStatusUpdate, ACLImportRowStatusUnchanged, ACLImportRowStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e ACLImportRowStatus) String() string {
+	return string(e)
+}
+
+func (e *ACLImportRowStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ACLImportRowStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ACLImportRowStatus", str)
+	}
+	return nil
+}
+
+func (e ACLImportRowStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ACLImportRowStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ACLImportRowStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type AggregateFunction string
+
+const (
+	AggregateFunctionSum   AggregateFunction = "SUM"
+	AggregateFunctionMin   AggregateFunction = "MIN"
+	AggregateFunctionMax   AggregateFunction = "MAX"
+	AggregateFunctionAvg   AggregateFunction = "AVG"
+	AggregateFunctionFirst AggregateFunction = "FIRST"
+	AggregateFunctionLast  AggregateFunction = "LAST"
+)
+
+var AllAggregateFunction = []AggregateFunction{
+	AggregateFunctionSum,
+	AggregateFunctionMin,
+	AggregateFunctionMax,
+	AggregateFunctionAvg,
+	AggregateFunctionFirst,
+	AggregateFunctionLast,
+}
+
+func (e AggregateFunction) IsValid() bool {
+	switch e {
+	case AggregateFunctionSum, AggregateFunctionMin, AggregateFunctionMax, AggregateFunctionAvg, AggregateFunctionFirst, AggregateFunctionLast:
+		return true
+	}
+	return false
+}
+
+func (e AggregateFunction) String() string {
+	return string(e)
+}
+
+func (e *AggregateFunction) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AggregateFunction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AggregateFunction", str)
+	}
+	return nil
+}
+
+func (e AggregateFunction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AggregateFunction) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AggregateFunction) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type FieldFilterMergeStrategy string
+
+const (
+	FieldFilterMergeStrategyUnion           FieldFilterMergeStrategy = "UNION"
+	FieldFilterMergeStrategyIntersection    FieldFilterMergeStrategy = "INTERSECTION"
+	FieldFilterMergeStrategyMostRestrictive FieldFilterMergeStrategy = "MOST_RESTRICTIVE"
+)
+
+var AllFieldFilterMergeStrategy = []FieldFilterMergeStrategy{
+	FieldFilterMergeStrategyUnion,
+	FieldFilterMergeStrategyIntersection,
+	FieldFilterMergeStrategyMostRestrictive,
+}
+
+func (e FieldFilterMergeStrategy) IsValid() bool {
+	switch e {
+	case FieldFilterMergeStrategyUnion, FieldFilterMergeStrategyIntersection, FieldFilterMergeStrategyMostRestrictive:
+		return true
+	}
+	return false
+}
+
+func (e FieldFilterMergeStrategy) String() string {
+	return string(e)
+}
+
+func (e *FieldFilterMergeStrategy) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FieldFilterMergeStrategy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FieldFilterMergeStrategy", str)
+	}
+	return nil
+}
+
+func (e FieldFilterMergeStrategy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *FieldFilterMergeStrategy) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e FieldFilterMergeStrategy) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type GrantKind string
+
+const (
+	GrantKindPermission  GrantKind = "PERMISSION"
+	GrantKindGroup       GrantKind = "GROUP"
+	GrantKindFieldFilter GrantKind = "FIELD_FILTER"
+)
+
+var AllGrantKind = []GrantKind{
+	GrantKindPermission,
+	GrantKindGroup,
+	GrantKindFieldFilter,
+}
+
+func (e GrantKind) IsValid() bool {
+	switch e {
+	case GrantKindPermission, GrantKindGroup, GrantKindFieldFilter:
+		return true
+	}
+	return false
+}
+
+func (e GrantKind) String() string {
+	return string(e)
+}
+
+func (e *GrantKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GrantKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GrantKind", str)
+	}
+	return nil
+}
+
+func (e GrantKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GrantKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GrantKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "ASC"
+	SortOrderDesc SortOrder = "DESC"
+)
+
+var AllSortOrder = []SortOrder{
+	SortOrderAsc,
+	SortOrderDesc,
+}
+
+func (e SortOrder) IsValid() bool {
+	switch e {
+	case SortOrderAsc, SortOrderDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortOrder) String() string {
+	return string(e)
+}
+
+func (e *SortOrder) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortOrder(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortOrder", str)
+	}
+	return nil
+}
+
+func (e SortOrder) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SortOrder) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SortOrder) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
 }
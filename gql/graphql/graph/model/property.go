@@ -0,0 +1,10 @@
+package model
+
+// Property is a building loans are made against. Properties have no table of their own: they
+// are found through the propertycode index of pbi-loaninfo, and LoanCodes links them to their
+// loans and, through those, to their cash flows.
+type Property struct {
+	PropertyCode string   `json:"propertyCode"`
+	PropertyName *string  `json:"propertyName,omitempty"`
+	LoanCodes    []string `json:"-"`
+}
@@ -0,0 +1,129 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DateLayout is the ISO-8601 form Date values are serialized in
+	DateLayout = "2006-01-02"
+	// DateTimeLayout is the ISO-8601 form DateTime values are serialized in; stored times carry no zone
+	DateTimeLayout = "2006-01-02T15:04:05"
+)
+
+// dateLayouts are accepted for Date input: ISO-8601 first, then the legacy US formats
+var dateLayouts = []string{
+	DateLayout,
+	"01/02/2006",
+	"1/2/2006",
+}
+
+// dateTimeLayouts are accepted for DateTime input. A date without a time means midnight.
+var dateTimeLayouts = []string{
+	time.RFC3339Nano,
+	DateTimeLayout,
+	DateLayout,
+	"01/02/2006 15:04:05",
+	"1/2/2006 3:04:05 PM",
+	"01/02/2006",
+	"1/2/2006",
+}
+
+// Date is a calendar date without a time of day
+type Date time.Time
+
+// DateTime is a point in time, serialized without a zone as the source data has none
+type DateTime time.Time
+
+// ParseDate reads a Date in ISO-8601 (yyyy-MM-dd) or legacy US (MM/dd/yyyy) format
+func ParseDate(value string) (Date, error) {
+	parsed, ok := parseLayouts(value, dateLayouts)
+	if !ok {
+		return Date{}, fmt.Errorf("invalid Date %q: expected yyyy-MM-dd or MM/dd/yyyy", value)
+	}
+	return Date(parsed), nil
+}
+
+// ParseDateTime reads a DateTime in ISO-8601 or legacy US format
+func ParseDateTime(value string) (DateTime, error) {
+	parsed, ok := parseLayouts(value, dateTimeLayouts)
+	if !ok {
+		return DateTime{}, fmt.Errorf("invalid DateTime %q: expected yyyy-MM-ddTHH:mm:ss, yyyy-MM-dd or MM/dd/yyyy", value)
+	}
+	return DateTime(parsed), nil
+}
+
+func parseLayouts(value string, layouts []string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	for _, layout := range layouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Time returns the date as midnight UTC
+func (d Date) Time() time.Time {
+	return time.Time(d)
+}
+
+// String formats the date as yyyy-MM-dd
+func (d Date) String() string {
+	return time.Time(d).Format(DateLayout)
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface
+func (d *Date) UnmarshalGQL(v any) error {
+	value, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("Date must be a string")
+	}
+
+	parsed, err := ParseDate(value)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalGQL implements the graphql.Marshaler interface
+func (d Date) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(d.String()))
+}
+
+// Time returns the point in time
+func (d DateTime) Time() time.Time {
+	return time.Time(d)
+}
+
+// String formats the time as yyyy-MM-ddTHH:mm:ss in UTC. The layout has no zone, so a time
+// parsed with an offset is converted rather than printed as its local wall clock.
+func (d DateTime) String() string {
+	return time.Time(d).UTC().Format(DateTimeLayout)
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface
+func (d *DateTime) UnmarshalGQL(v any) error {
+	value, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("DateTime must be a string")
+	}
+
+	parsed, err := ParseDateTime(value)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalGQL implements the graphql.Marshaler interface
+func (d DateTime) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(d.String()))
+}
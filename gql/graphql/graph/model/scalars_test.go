@@ -0,0 +1,36 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateTimeStringIsUTC(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "2024-01-15T10:30:00", want: "2024-01-15T10:30:00"},
+		{input: "2024-01-15T10:30:00Z", want: "2024-01-15T10:30:00"},
+		{input: "2024-01-15T10:30:00+02:00", want: "2024-01-15T08:30:00"},
+		{input: "2024-01-15T22:30:00-05:00", want: "2024-01-16T03:30:00"},
+		{input: "1/15/2024 10:30:00 AM", want: "2024-01-15T10:30:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			parsed, err := ParseDateTime(tt.input)
+			if err != nil {
+				t.Fatalf("ParseDateTime: %v", err)
+			}
+			if got := parsed.String(); got != tt.want {
+				t.Errorf("String() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	local := DateTime(time.Date(2024, 1, 15, 10, 30, 0, 0, time.FixedZone("EST", -5*60*60)))
+	if got := local.String(); got != "2024-01-15T15:30:00" {
+		t.Errorf("String() of a zoned time = %s, want it in UTC", got)
+	}
+}
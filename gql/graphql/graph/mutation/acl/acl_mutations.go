@@ -2,11 +2,18 @@ package acl
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"path"
+	"slices"
 	"ssot/gql/graphql/graph/model"
+	graphservices "ssot/gql/graphql/graph/services"
 	"ssot/gql/graphql/internal/acl"
 	"ssot/gql/graphql/internal/services"
 	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
 )
 
 // validateGroupName validates that a group name starts with "group:"
@@ -68,33 +75,40 @@ func (r *ACLMutationResolver) AddUserACL(ctx context.Context, input model.AddUse
 	}
 
 	// Convert permissions from GraphQL to ACL format
-	permissions := convertPermissionsToACL(input.Permissions)
-	fieldFilters := convertFieldFiltersToACL(input.FieldFilters)
-
-	// Create the user ACL
-	err := r.ServiceManager.ACLService.CreateUserWithFieldFilters(
-		ctx, input.Email, input.Groups, permissions, fieldFilters)
+	permissions, permissionValidity, err := convertPermissionsToACL(input.Permissions)
 	if err != nil {
 		return &model.ACLMutationResult{
 			Success: false,
-			Message: fmt.Sprintf("Failed to create user ACL: %v", err),
+			Message: fmt.Sprintf("Invalid permission: %v", err),
 		}, nil
 	}
-
-	// Fetch the created record for response
-	record, err := r.ServiceManager.ACLService.GetMergedACL(ctx, input.Email)
+	groupValidity, err := convertGroupValidityToACL(input.GroupValidity, input.Groups)
 	if err != nil {
-		// Return success but without the record
 		return &model.ACLMutationResult{
-			Success: true,
-			Message: "User ACL created successfully",
+			Success: false,
+			Message: fmt.Sprintf("Invalid group validity: %v", err),
+		}, nil
+	}
+	fieldFilters, err := convertFieldFiltersToACL(input.FieldFilters)
+	if err != nil {
+		return &model.ACLMutationResult{
+			Success: false,
+			Message: fmt.Sprintf("Invalid field filter: %v", err),
 		}, nil
 	}
 
+	// Create the user ACL
+	record, err := r.ServiceManager.ACLService.CreateUserWithFieldFilters(
+		ctx, input.Email, input.Groups, permissions, fieldFilters, permissionValidity, groupValidity)
+	if err != nil {
+		return writeFailureResult("create user ACL", err), nil
+	}
+
+	// Return the record as written, so its version and timestamp can guard the next update
 	return &model.ACLMutationResult{
 		Success: true,
 		Message: "User ACL created successfully",
-		Record:  convertACLToGraphQL(record, input.Email),
+		Record:  convertACLRecordToGraphQL(record),
 	}, nil
 }
 
@@ -130,44 +144,58 @@ func (r *ACLMutationResolver) UpdateUserACL(ctx context.Context, input model.Upd
 		}
 	}
 
-	// Update groups if provided
-	if len(input.Groups) > 0 {
-		err := r.ServiceManager.ACLService.UpdateUserGroups(ctx, input.Email, input.Groups)
-		if err != nil {
-			return &model.ACLMutationResult{
-				Success: false,
-				Message: fmt.Sprintf("Failed to update user groups: %v", err),
-			}, nil
-		}
+	// Convert inputs before writing anything, so invalid input changes nothing
+	groupValidity, err := convertGroupValidityToACL(input.GroupValidity, input.Groups)
+	if err != nil {
+		return &model.ACLMutationResult{
+			Success: false,
+			Message: fmt.Sprintf("Invalid group validity: %v", err),
+		}, nil
 	}
+	permissions, permissionValidity, err := convertPermissionsToACL(input.Permissions)
+	if err != nil {
+		return &model.ACLMutationResult{
+			Success: false,
+			Message: fmt.Sprintf("Invalid permission: %v", err),
+		}, nil
+	}
+	expected := convertExpectedStateToACL(input.ExpectedVersion, input.ExpectedUpdatedAt)
 
-	// Update permissions if provided
+	// Update the groups and permissions provided, in a single write
+	var update acl.RecordUpdate
+	if len(input.Groups) > 0 {
+		update.Groups, update.GroupValidity = input.Groups, groupValidity
+	}
 	if len(input.Permissions) > 0 {
-		permissions := convertPermissionsToACL(input.Permissions)
-		err := r.ServiceManager.ACLService.UpdateUserPermissions(ctx, input.Email, permissions)
+		update.Permissions, update.PermissionValidity = permissions, permissionValidity
+	}
+	var record *acl.ACLRecord
+	if update.Groups != nil || update.Permissions != nil {
+		record, err = r.ServiceManager.ACLService.UpdateUser(ctx, input.Email, update, expected)
+		if err != nil {
+			return writeFailureResult("update user ACL", err), nil
+		}
+	} else {
+		// Nothing to write, so report the record as stored
+		record, err = r.ServiceManager.ACLService.GetRecord(ctx, input.Email)
 		if err != nil {
+			// Return success but without the record
 			return &model.ACLMutationResult{
-				Success: false,
-				Message: fmt.Sprintf("Failed to update user permissions: %v", err),
+				Success: true,
+				Message: "User ACL updated successfully",
 			}, nil
 		}
 	}
 
-	// Fetch the updated record for response
-	record, err := r.ServiceManager.ACLService.GetMergedACL(ctx, input.Email)
-	if err != nil {
-		// Return success but without the record
-		return &model.ACLMutationResult{
-			Success: true,
-			Message: "User ACL updated successfully",
-		}, nil
-	}
-
-	return &model.ACLMutationResult{
+	// Return the record as written, so its version and timestamp can guard the next update
+	result := &model.ACLMutationResult{
 		Success: true,
 		Message: "User ACL updated successfully",
-		Record:  convertACLToGraphQL(record, input.Email),
-	}, nil
+	}
+	if record != nil {
+		result.Record = convertACLRecordToGraphQL(record)
+	}
+	return result, nil
 }
 
 // AddGroupACL creates a new group ACL record
@@ -196,20 +224,48 @@ func (r *ACLMutationResolver) AddGroupACL(ctx context.Context, input model.AddGr
 		}, nil
 	}
 
+	if result := r.validateGroupParents(ctx, input.GroupName, input.Groups); result != nil {
+		return result, nil
+	}
+
 	// Convert permissions from GraphQL to ACL format
-	permissions := convertPermissionsToACL(input.Permissions)
-	fieldFilters := convertFieldFiltersToACL(input.FieldFilters)
+	permissions, permissionValidity, err := convertPermissionsToACL(input.Permissions)
+	if err != nil {
+		return &model.ACLMutationResult{
+			Success: false,
+			Message: fmt.Sprintf("Invalid permission: %v", err),
+		}, nil
+	}
+	groupValidity, err := convertGroupValidityToACL(input.GroupValidity, input.Groups)
+	if err != nil {
+		return &model.ACLMutationResult{
+			Success: false,
+			Message: fmt.Sprintf("Invalid group validity: %v", err),
+		}, nil
+	}
+	fieldFilters, err := convertFieldFiltersToACL(input.FieldFilters)
+	if err != nil {
+		return &model.ACLMutationResult{
+			Success: false,
+			Message: fmt.Sprintf("Invalid field filter: %v", err),
+		}, nil
+	}
 
-	// Create the group ACL
-	err := r.ServiceManager.ACLService.CreateGroupWithFieldFilters(
-		ctx, input.GroupName, permissions, fieldFilters)
+	mergeStrategies, err := convertMergeStrategiesToACL(input.MergeStrategies)
 	if err != nil {
 		return &model.ACLMutationResult{
 			Success: false,
-			Message: fmt.Sprintf("Failed to create group ACL: %v", err),
+			Message: fmt.Sprintf("Invalid merge strategy: %v", err),
 		}, nil
 	}
 
+	// Create the group ACL
+	err = r.ServiceManager.ACLService.CreateGroupWithFieldFilters(
+		ctx, input.GroupName, input.Groups, permissions, fieldFilters, mergeStrategies, permissionValidity, groupValidity)
+	if err != nil {
+		return writeFailureResult("create group ACL", err), nil
+	}
+
 	return &model.ACLMutationResult{
 		Success: true,
 		Message: fmt.Sprintf("Group ACL '%s' created successfully", input.GroupName),
@@ -242,17 +298,52 @@ func (r *ACLMutationResolver) UpdateGroupACL(ctx context.Context, input model.Up
 		}, nil
 	}
 
-	// Update permissions if provided
-	if len(input.Permissions) > 0 {
-		permissions := convertPermissionsToACL(input.Permissions)
-		err := r.ServiceManager.ACLService.UpdateGroupPermissions(ctx, input.GroupName, permissions)
+	// Convert inputs before writing anything, so invalid input changes nothing
+	groupValidity, err := convertGroupValidityToACL(input.GroupValidity, input.Groups)
+	if err != nil {
+		return &model.ACLMutationResult{
+			Success: false,
+			Message: fmt.Sprintf("Invalid group validity: %v", err),
+		}, nil
+	}
+	permissions, permissionValidity, err := convertPermissionsToACL(input.Permissions)
+	if err != nil {
+		return &model.ACLMutationResult{
+			Success: false,
+			Message: fmt.Sprintf("Invalid permission: %v", err),
+		}, nil
+	}
+	var mergeStrategies map[string]acl.FieldFilterMergeStrategy
+	if input.MergeStrategies != nil {
+		mergeStrategies, err = convertMergeStrategiesToACL(input.MergeStrategies)
 		if err != nil {
 			return &model.ACLMutationResult{
 				Success: false,
-				Message: fmt.Sprintf("Failed to update group permissions: %v", err),
+				Message: fmt.Sprintf("Invalid merge strategy: %v", err),
 			}, nil
 		}
 	}
+	expected := convertExpectedStateToACL(input.ExpectedVersion, input.ExpectedUpdatedAt)
+
+	// Update the parent groups, permissions and merge strategies provided, in a single write
+	var update acl.RecordUpdate
+	if input.Groups != nil {
+		if result := r.validateGroupParents(ctx, input.GroupName, input.Groups); result != nil {
+			return result, nil
+		}
+		update.Groups, update.GroupValidity = input.Groups, groupValidity
+	}
+	if len(input.Permissions) > 0 {
+		update.Permissions, update.PermissionValidity = permissions, permissionValidity
+	}
+	if input.MergeStrategies != nil {
+		update.MergeStrategies = mergeStrategies
+	}
+	if update.Groups != nil || update.Permissions != nil || update.MergeStrategies != nil {
+		if _, err := r.ServiceManager.ACLService.UpdateGroup(ctx, input.GroupName, update, expected); err != nil {
+			return writeFailureResult("update group ACL", err), nil
+		}
+	}
 
 	return &model.ACLMutationResult{
 		Success: true,
@@ -260,6 +351,36 @@ func (r *ACLMutationResolver) UpdateGroupACL(ctx context.Context, input model.Up
 	}, nil
 }
 
+// validateGroupParents checks the groups a group is to inherit from, returning the failed
+// result to send if they are not acceptable
+func (r *ACLMutationResolver) validateGroupParents(ctx context.Context, groupName string, parents []string) *model.ACLMutationResult {
+	if err := validateGroupNames(parents); err != nil {
+		return &model.ACLMutationResult{
+			Success: false,
+			Message: fmt.Sprintf("Invalid group name: %v", err),
+		}
+	}
+
+	// Inheriting from the admin group would grant admin permissions
+	for _, group := range parents {
+		if group == "group:admin" {
+			return &model.ACLMutationResult{
+				Success: false,
+				Message: "Cannot inherit from admin group through ACL configuration",
+			}
+		}
+	}
+
+	if err := r.ServiceManager.ACLService.ValidateGroupParents(ctx, groupName, parents); err != nil {
+		return &model.ACLMutationResult{
+			Success: false,
+			Message: fmt.Sprintf("Invalid group inheritance: %v", err),
+		}
+	}
+
+	return nil
+}
+
 // DeleteUserACL deletes a user ACL record
 func (r *ACLMutationResolver) DeleteUserACL(ctx context.Context, email string) (*model.ACLMutationResult, error) {
 	// Check admin access
@@ -273,10 +394,7 @@ func (r *ACLMutationResolver) DeleteUserACL(ctx context.Context, email string) (
 	// Delete the user ACL
 	err := r.ServiceManager.ACLService.DeleteUser(ctx, email)
 	if err != nil {
-		return &model.ACLMutationResult{
-			Success: false,
-			Message: fmt.Sprintf("Failed to delete user ACL: %v", err),
-		}, nil
+		return writeFailureResult("delete user ACL", err), nil
 	}
 
 	return &model.ACLMutationResult{
@@ -314,10 +432,7 @@ func (r *ACLMutationResolver) DeleteGroupACL(ctx context.Context, groupName stri
 	// Delete the group ACL
 	err := r.ServiceManager.ACLService.DeleteGroup(ctx, groupName)
 	if err != nil {
-		return &model.ACLMutationResult{
-			Success: false,
-			Message: fmt.Sprintf("Failed to delete group ACL: %v", err),
-		}, nil
+		return writeFailureResult("delete group ACL", err), nil
 	}
 
 	return &model.ACLMutationResult{
@@ -328,63 +443,177 @@ func (r *ACLMutationResolver) DeleteGroupACL(ctx context.Context, groupName stri
 
 // Helper functions for converting between GraphQL models and ACL types
 
-// convertPermissionsToACL converts GraphQL PermissionInput to ACL format
-func convertPermissionsToACL(permissions []*model.PermissionInput) map[string]string {
+// convertPermissionsToACL converts GraphQL PermissionInput to ACL format, along with the
+// validity windows of time-bound permissions
+func convertPermissionsToACL(permissions []*model.PermissionInput) (map[string]string, map[string]acl.Validity, error) {
 	result := make(map[string]string)
+	validities := make(map[string]acl.Validity)
 	for _, perm := range permissions {
-		key := fmt.Sprintf("%s#*", perm.Table) // Table-level permission format
+		column := "*" // Table-level permission format
+		if perm.Column != nil && *perm.Column != "" {
+			column = *perm.Column
+		}
+		key := fmt.Sprintf("%s#%s", perm.Table, column)
 		result[key] = perm.Action
+
+		validity, err := parseValidity(perm.ValidFrom, perm.ValidUntil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", key, err)
+		}
+		if validity != (acl.Validity{}) {
+			validities[key] = validity
+		}
+	}
+	return result, validities, nil
+}
+
+// convertGroupValidityToACL converts GraphQL GroupGrantInput to ACL format. Each grant must
+// name one of groups.
+func convertGroupValidityToACL(grants []*model.GroupGrantInput, groups []string) (map[string]acl.Validity, error) {
+	result := make(map[string]acl.Validity)
+	for _, grant := range grants {
+		if !slices.Contains(groups, grant.Group) {
+			return nil, fmt.Errorf("%s is not one of the groups being set", grant.Group)
+		}
+		validity, err := parseValidity(grant.ValidFrom, grant.ValidUntil)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", grant.Group, err)
+		}
+		result[grant.Group] = validity
+	}
+	return result, nil
+}
+
+// convertExpectedStateToACL converts the record state an update input was based on, or returns
+// nil if the input names none
+func convertExpectedStateToACL(version *int32, updatedAt *string) *acl.Precondition {
+	if version == nil && updatedAt == nil {
+		return nil
+	}
+
+	expected := &acl.Precondition{}
+	if version != nil {
+		expectedVersion := int(*version)
+		expected.Version = &expectedVersion
+	}
+	if updatedAt != nil {
+		expected.UpdatedAt = *updatedAt
+	}
+	return expected
+}
+
+// writeFailureResult reports a failed write. A conflict with a concurrent change is flagged and
+// carries the current record, so the caller can reapply their change to it.
+func writeFailureResult(action string, err error) *model.ACLMutationResult {
+	result := &model.ACLMutationResult{
+		Success: false,
+		Message: fmt.Sprintf("Failed to %s: %v", action, err),
+	}
+
+	var conflict *acl.ConflictError
+	if errors.As(err, &conflict) {
+		result.Conflict = true
+		if conflict.Current != nil {
+			result.Record = convertACLRecordToGraphQL(conflict.Current)
+		}
 	}
 	return result
 }
 
-// convertFieldFiltersToACL converts GraphQL FieldFilterInput to ACL format
-func convertFieldFiltersToACL(filters []*model.FieldFilterInput) map[string]acl.FieldFilter {
+// parseValidity reads the RFC 3339 bounds of a validity window; missing bounds stay open
+func parseValidity(validFrom, validUntil *string) (acl.Validity, error) {
+	var validity acl.Validity
+	for _, bound := range []struct {
+		name   string
+		value  *string
+		target *time.Time
+	}{
+		{"validFrom", validFrom, &validity.ValidFrom},
+		{"validUntil", validUntil, &validity.ValidUntil},
+	} {
+		if bound.value == nil || *bound.value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, *bound.value)
+		if err != nil {
+			return validity, fmt.Errorf("%s must be an RFC 3339 timestamp: %w", bound.name, err)
+		}
+		*bound.target = parsed
+	}
+
+	if !validity.ValidFrom.IsZero() && !validity.ValidUntil.IsZero() && !validity.ValidUntil.After(validity.ValidFrom) {
+		return validity, fmt.Errorf("validUntil must be after validFrom")
+	}
+	return validity, nil
+}
+
+// formatValidityBound renders a validity bound for GraphQL, or nil if it is open
+func formatValidityBound(bound time.Time) *string {
+	if bound.IsZero() {
+		return nil
+	}
+	formatted := bound.UTC().Format(time.RFC3339)
+	return &formatted
+}
+
+// convertFieldFiltersToACL converts GraphQL FieldFilterInput to ACL format, rejecting filters
+// on fields no dataset has, which would otherwise be stored and never applied, and values that
+// are not valid patterns
+func convertFieldFiltersToACL(filters []*model.FieldFilterInput) (map[string]acl.FieldFilter, error) {
 	result := make(map[string]acl.FieldFilter)
 	for _, filter := range filters {
-		result[filter.Field] = acl.FieldFilter{
+		if !graphservices.IsKnownFieldFilter(filter.Field) {
+			return nil, fmt.Errorf("unknown field %s", filter.Field)
+		}
+		fieldFilter := acl.FieldFilter{
 			Field:       filter.Field,
 			IncludeList: filter.IncludeList,
 			ExcludeList: filter.ExcludeList,
 			FilterType:  filter.FilterType,
 		}
+		if err := fieldFilter.Validate(); err != nil {
+			return nil, fmt.Errorf("field %s: %w", filter.Field, err)
+		}
+		validity, err := parseValidity(filter.ValidFrom, filter.ValidUntil)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", filter.Field, err)
+		}
+		fieldFilter.Validity = validity
+		result[filter.Field] = fieldFilter
 	}
-	return result
+	return result, nil
 }
 
-// convertACLToGraphQL converts ACL MergedACL to GraphQL ACLRecord
-func convertACLToGraphQL(acl *acl.MergedACL, principalID string) *model.ACLRecord {
-	// Convert permissions map to GraphQL Permission slice
-	var permissions []*model.Permission
-	for key, action := range acl.Permissions {
-		// Parse table from key format "table#*"
-		parts := strings.Split(key, "#")
-		if len(parts) >= 1 {
-			permissions = append(permissions, &model.Permission{
-				Table:  parts[0],
-				Action: action,
-			})
+// convertMergeStrategiesToACL converts GraphQL MergeStrategyInput to ACL format, rejecting
+// strategies for fields no dataset has
+func convertMergeStrategiesToACL(strategies []*model.MergeStrategyInput) (map[string]acl.FieldFilterMergeStrategy, error) {
+	result := make(map[string]acl.FieldFilterMergeStrategy)
+	for _, strategy := range strategies {
+		if !graphservices.IsKnownFieldFilter(strategy.Field) {
+			return nil, fmt.Errorf("unknown field %s", strategy.Field)
 		}
+		result[strategy.Field] = acl.FieldFilterMergeStrategy(strings.ToLower(string(strategy.Strategy)))
 	}
+	return result, nil
+}
 
-	// Convert field filters map to GraphQL FieldFilter slice
-	var fieldFilters []*model.FieldFilter
-	for _, filter := range acl.FieldFilters {
-		fieldFilters = append(fieldFilters, &model.FieldFilter{
-			Field:       filter.Field,
-			IncludeList: filter.IncludeList,
-			ExcludeList: filter.ExcludeList,
-			FilterType:  filter.FilterType,
-		})
+// convertFieldFilterToGraphQL converts an ACL FieldFilter, including the filters a merged one combines
+func convertFieldFilterToGraphQL(filter acl.FieldFilter) *model.FieldFilter {
+	converted := &model.FieldFilter{
+		Field:       filter.Field,
+		IncludeList: filter.IncludeList,
+		ExcludeList: filter.ExcludeList,
+		FilterType:  filter.FilterType,
+		ValidFrom:   formatValidityBound(filter.ValidFrom),
+		ValidUntil:  formatValidityBound(filter.ValidUntil),
 	}
-
-	return &model.ACLRecord{
-		PrincipalID:  principalID,
-		Groups:       acl.Groups,
-		Permissions:  permissions,
-		FieldFilters: fieldFilters,
-		UpdatedAt:    acl.CachedAt.Format("2006-01-02T15:04:05Z07:00"),
+	for _, child := range filter.AllOf {
+		converted.AllOf = append(converted.AllOf, convertFieldFilterToGraphQL(child))
+	}
+	for _, child := range filter.AnyOf {
+		converted.AnyOf = append(converted.AnyOf, convertFieldFilterToGraphQL(child))
 	}
+	return converted
 }
 
 // convertACLRecordToGraphQL converts ACL ACLRecord to GraphQL ACLRecord
@@ -392,32 +621,193 @@ func convertACLRecordToGraphQL(record *acl.ACLRecord) *model.ACLRecord {
 	// Convert permissions map to GraphQL Permission slice
 	var permissions []*model.Permission
 	for key, action := range record.Permissions {
-		// Parse table from key format "table#*"
-		parts := strings.Split(key, "#")
-		if len(parts) >= 1 {
-			permissions = append(permissions, &model.Permission{
-				Table:  parts[0],
-				Action: action,
-			})
+		// Parse table and column from key format "table#column"
+		table, column, found := strings.Cut(key, "#")
+		if !found {
+			column = "*"
 		}
+		validity := record.PermissionValidity[key]
+		permissions = append(permissions, &model.Permission{
+			Table:      table,
+			Column:     column,
+			Action:     action,
+			ValidFrom:  formatValidityBound(validity.ValidFrom),
+			ValidUntil: formatValidityBound(validity.ValidUntil),
+		})
+	}
+
+	// Convert group validity windows to GraphQL GroupGrant slice
+	var groupValidity []*model.GroupGrant
+	for _, group := range record.Groups {
+		validity, ok := record.GroupValidity[group]
+		if !ok {
+			continue
+		}
+		groupValidity = append(groupValidity, &model.GroupGrant{
+			Group:      group,
+			ValidFrom:  formatValidityBound(validity.ValidFrom),
+			ValidUntil: formatValidityBound(validity.ValidUntil),
+		})
 	}
 
 	// Convert field filters map to GraphQL FieldFilter slice
 	var fieldFilters []*model.FieldFilter
 	for _, filter := range record.FieldFilters {
-		fieldFilters = append(fieldFilters, &model.FieldFilter{
-			Field:       filter.Field,
-			IncludeList: filter.IncludeList,
-			ExcludeList: filter.ExcludeList,
-			FilterType:  filter.FilterType,
+		fieldFilters = append(fieldFilters, convertFieldFilterToGraphQL(filter))
+	}
+
+	// Convert merge strategies map to GraphQL MergeStrategy slice
+	var mergeStrategies []*model.MergeStrategy
+	for field, strategy := range record.MergeStrategies {
+		mergeStrategies = append(mergeStrategies, &model.MergeStrategy{
+			Field:    field,
+			Strategy: model.FieldFilterMergeStrategy(strings.ToUpper(string(strategy))),
 		})
 	}
 
 	return &model.ACLRecord{
-		PrincipalID:  record.PrincipalID,
-		Groups:       record.Groups,
-		Permissions:  permissions,
-		FieldFilters: fieldFilters,
-		UpdatedAt:    record.UpdatedAt,
+		PrincipalID:     record.PrincipalID,
+		Groups:          record.Groups,
+		Permissions:     permissions,
+		FieldFilters:    fieldFilters,
+		GroupValidity:   groupValidity,
+		MergeStrategies: mergeStrategies,
+		UpdatedAt:       record.UpdatedAt,
+		Version:         int32(record.Version),
+	}
+}
+
+// RollbackACL restores a user or group ACL record to an earlier version
+func (r *ACLMutationResolver) RollbackACL(ctx context.Context, principalID string, version int32) (*model.ACLMutationResult, error) {
+	// Check admin access
+	if err := r.ServiceManager.ACLMiddleware.RequireAdminAccess(ctx); err != nil {
+		return &model.ACLMutationResult{
+			Success: false,
+			Message: fmt.Sprintf("Access denied: %v", err),
+		}, nil
+	}
+
+	// Prevent rolling back the admin group
+	if principalID == "admin" || principalID == "group:admin" {
+		return &model.ACLMutationResult{
+			Success: false,
+			Message: "Cannot roll back admin group through ACL configuration",
+		}, nil
+	}
+
+	// Prevent restoring a membership of the admin group
+	target, err := r.ServiceManager.ACLService.GetRecordVersion(ctx, principalID, int(version))
+	if err != nil {
+		return &model.ACLMutationResult{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get version %d: %v", version, err),
+		}, nil
+	}
+	if target != nil && !target.Deleted() && slices.Contains(target.Record.Groups, "group:admin") {
+		return &model.ACLMutationResult{
+			Success: false,
+			Message: "Cannot assign admin group through ACL configuration",
+		}, nil
+	}
+
+	// Restore the version
+	record, err := r.ServiceManager.ACLService.RollbackRecord(ctx, principalID, int(version))
+	if err != nil {
+		return writeFailureResult("roll back ACL", err), nil
+	}
+
+	return &model.ACLMutationResult{
+		Success: true,
+		Message: fmt.Sprintf("ACL for '%s' rolled back to version %d as version %d", principalID, version, record.Version),
+		Record:  convertACLRecordToGraphQL(record),
+	}, nil
+}
+
+// ImportACL creates or updates the user and group records of an ACL file
+func (r *ACLMutationResolver) ImportACL(ctx context.Context, file graphql.Upload, format *model.ACLFileFormat, mode model.ACLImportMode, dryRun bool) (*model.ACLImportResult, error) {
+	// Check admin access
+	if err := r.ServiceManager.ACLMiddleware.RequireAdminAccess(ctx); err != nil {
+		return &model.ACLImportResult{
+			Success: false,
+			Message: fmt.Sprintf("Access denied: %v", err),
+			Rows:    []*model.ACLImportRowResult{},
+		}, nil
+	}
+
+	fileFormat, err := importFileFormat(format, file.Filename)
+	if err != nil {
+		return &model.ACLImportResult{
+			Success: false,
+			Message: fmt.Sprintf("Invalid file: %v", err),
+			Rows:    []*model.ACLImportRowResult{},
+		}, nil
+	}
+	rows, err := acl.ReadACLFile(file.File, fileFormat)
+	if err != nil {
+		return &model.ACLImportResult{
+			Success: false,
+			Message: fmt.Sprintf("Invalid file: %v", err),
+			Rows:    []*model.ACLImportRowResult{},
+		}, nil
+	}
+
+	// Validate every row and, unless this is a dry run, write the records
+	result, err := r.ServiceManager.ACLService.ImportRecords(
+		ctx, rows, acl.ImportMode(strings.ToLower(string(mode))), dryRun, graphservices.IsKnownFieldFilter)
+	if err != nil {
+		return &model.ACLImportResult{
+			Success: false,
+			Message: fmt.Sprintf("Failed to import ACL: %v", err),
+			Rows:    []*model.ACLImportRowResult{},
+		}, nil
+	}
+
+	gqlRows := make([]*model.ACLImportRowResult, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		gqlRow := &model.ACLImportRowResult{
+			Line:        int32(row.Line),
+			PrincipalID: row.PrincipalID,
+			Status:      model.ACLImportRowStatus(strings.ToUpper(string(row.Status))),
+		}
+		if row.Message != "" {
+			gqlRow.Message = &row.Message
+		}
+		gqlRows = append(gqlRows, gqlRow)
+	}
+
+	invalid, failed := result.Count(acl.ImportRowInvalid), result.Count(acl.ImportRowFailed)
+	created, updated := result.Count(acl.ImportRowCreate), result.Count(acl.ImportRowUpdate)
+	var message string
+	switch {
+	case invalid > 0:
+		message = fmt.Sprintf("%d of %d rows are invalid; nothing was imported", invalid, len(rows))
+	case !result.Applied:
+		message = fmt.Sprintf("Dry run: %d rows would create records and %d would update them", created, updated)
+	case failed > 0:
+		message = fmt.Sprintf("Imported with %d rows failed: %d rows created records and %d updated them", failed, created, updated)
+	default:
+		message = fmt.Sprintf("Imported: %d rows created records and %d updated them", created, updated)
+	}
+
+	return &model.ACLImportResult{
+		Success: invalid == 0 && failed == 0,
+		Applied: result.Applied,
+		Message: message,
+		Rows:    gqlRows,
+	}, nil
+}
+
+// importFileFormat returns the format of an imported file: the one given, or else the one its
+// file name's extension names
+func importFileFormat(format *model.ACLFileFormat, filename string) (acl.ACLFileFormat, error) {
+	if format != nil {
+		return acl.ACLFileFormat(strings.ToLower(string(*format))), nil
+	}
+
+	switch extension := strings.ToLower(path.Ext(filename)); extension {
+	case ".json", ".csv", ".xlsx":
+		return acl.ACLFileFormat(extension[1:]), nil
+	default:
+		return "", fmt.Errorf("cannot tell the format of %s from its extension; pass format", filename)
 	}
 }
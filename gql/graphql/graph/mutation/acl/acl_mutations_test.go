@@ -0,0 +1,198 @@
+package acl
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"ssot/gql/graphql/graph/model"
+	"ssot/gql/graphql/internal/auth"
+	"ssot/gql/graphql/internal/dynamotest"
+	"ssot/gql/graphql/internal/services"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// aclTable serves the ACL table and its version table from memory, applying the conditions of
+// the record writes the way DynamoDB would
+type aclTable struct {
+	mu       sync.Mutex
+	records  map[string]dynamotest.Item
+	versions map[string]int
+}
+
+func newACLTable(t *testing.T, records ...dynamotest.Item) (*aclTable, *dynamotest.Server) {
+	table := &aclTable{records: make(map[string]dynamotest.Item), versions: make(map[string]int)}
+	for _, record := range records {
+		table.records[principalOf(record)] = record
+	}
+
+	server := dynamotest.NewServer(t)
+	server.Handle("GetItem", table.getItem)
+	server.Handle("BatchGetItem", func(input map[string]any) (any, error) {
+		return map[string]any{"Responses": map[string]any{}}, nil
+	})
+	server.Handle("Query", table.latestVersion)
+	server.Handle("PutItem", func(input map[string]any) (any, error) {
+		return map[string]any{}, nil
+	})
+	server.Handle("TransactWriteItems", table.transactWrite)
+	return table, server
+}
+
+func (a *aclTable) getItem(input map[string]any) (any, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	key, _ := input["Key"].(map[string]any)
+	if record, ok := a.records[principalOf(key)]; ok {
+		return map[string]any{"Item": record}, nil
+	}
+	return map[string]any{}, nil
+}
+
+func (a *aclTable) latestVersion(input map[string]any) (any, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, principal := range dynamotest.StringValues(input) {
+		if version, ok := a.versions[principal]; ok {
+			return map[string]any{"Items": []dynamotest.Item{{"Version": dynamotest.N(strconv.Itoa(version))}}}, nil
+		}
+	}
+	return map[string]any{"Items": []dynamotest.Item{}}, nil
+}
+
+func (a *aclTable) transactWrite(input map[string]any) (any, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	items, _ := input["TransactItems"].([]any)
+	put := items[0].(map[string]any)["Put"].(map[string]any)
+	item := put["Item"].(map[string]any)
+	current, found := a.records[principalOf(item)]
+
+	condition, _ := put["ConditionExpression"].(string)
+	values, _ := put["ExpressionAttributeValues"].(map[string]any)
+	holds := true
+	if strings.Contains(condition, "attribute_not_exists(#principal)") {
+		holds = !found
+	}
+	if version, ok := values[":version"]; ok {
+		holds = holds && found && sameValue(current["Version"], version)
+	}
+	if updatedAt, ok := values[":updatedAt"]; ok {
+		holds = holds && found && sameValue(current["UpdatedAt"], updatedAt)
+	}
+	if !holds {
+		return nil, &dynamotest.Error{
+			Type:    "TransactionCanceledException",
+			Message: "Transaction cancelled",
+			Fields: map[string]any{"CancellationReasons": []any{
+				map[string]any{"Code": "ConditionalCheckFailed"},
+				map[string]any{"Code": "None"},
+				map[string]any{"Code": "None"},
+			}},
+		}
+	}
+
+	version, _ := item["Version"].(map[string]any)["N"].(string)
+	a.records[principalOf(item)] = item
+	a.versions[principalOf(item)], _ = strconv.Atoi(version)
+	return map[string]any{}, nil
+}
+
+func principalOf(item map[string]any) string {
+	principal, _ := item["PrincipalID"].(map[string]any)
+	s, _ := principal["S"].(string)
+	return s
+}
+
+func sameValue(a, b any) bool {
+	x, _ := a.(map[string]any)
+	y, _ := b.(map[string]any)
+	return x != nil && y != nil && x["S"] == y["S"] && x["N"] == y["N"]
+}
+
+func newTestResolver(t *testing.T, server *dynamotest.Server) (*ACLMutationResolver, context.Context) {
+	serviceManager := services.NewServiceManager(t.Context(), services.ServiceConfig{
+		DynamoClient:        server.Client(),
+		ACLTableName:        "acl",
+		ACLAuditTableName:   "acl-audit",
+		ACLVersionTableName: "acl-versions",
+	})
+	ctx := context.WithValue(t.Context(), auth.UserContextKey, &auth.User{Email: "admin@example.com"})
+	return NewACLMutationResolver(serviceManager), ctx
+}
+
+var adminRecord = dynamotest.Item{
+	"PrincipalID": dynamotest.S("admin@example.com"),
+	"Groups":      map[string]any{"L": []any{dynamotest.S("group:admin")}},
+}
+
+func TestUserACLMutationsReturnWrittenRecord(t *testing.T) {
+	table, server := newACLTable(t, adminRecord)
+	resolver, ctx := newTestResolver(t, server)
+
+	added, err := resolver.AddUserACL(ctx, model.AddUserACLInput{
+		Email:       "user@example.com",
+		Groups:      []string{},
+		Permissions: []*model.PermissionInput{{Table: "LoanInfo", Column: aws.String("*"), Action: "read"}},
+	})
+	if err != nil || !added.Success || added.Record == nil {
+		t.Fatalf("AddUserACL = %+v, %v", added, err)
+	}
+
+	record := added.Record
+	for i, action := range []string{"readwrite", "read"} {
+		result, err := resolver.UpdateUserACL(ctx, model.UpdateUserACLInput{
+			Email:             "user@example.com",
+			Permissions:       []*model.PermissionInput{{Table: "LoanInfo", Column: aws.String("*"), Action: action}},
+			ExpectedVersion:   &record.Version,
+			ExpectedUpdatedAt: &record.UpdatedAt,
+		})
+		if err != nil || !result.Success || result.Record == nil {
+			t.Fatalf("update %d based on the previous result = %+v, %v", i+1, result, err)
+		}
+		record = result.Record
+
+		stored := table.records["user@example.com"]
+		if want := dynamotest.S(record.UpdatedAt); !sameValue(stored["UpdatedAt"], want) {
+			t.Errorf("update %d returned updatedAt %s, stored %v", i+1, record.UpdatedAt, stored["UpdatedAt"])
+		}
+		if want := int32(i + 2); record.Version != want {
+			t.Errorf("update %d returned version %d, want %d", i+1, record.Version, want)
+		}
+	}
+}
+
+func TestUpdateUserACLConflict(t *testing.T) {
+	table, server := newACLTable(t, adminRecord, dynamotest.Item{
+		"PrincipalID": dynamotest.S("user@example.com"),
+		"Version":     dynamotest.N("2"),
+		"UpdatedAt":   dynamotest.S("2024-05-01T10:00:00.123456789Z"),
+	})
+	table.versions["user@example.com"] = 2
+	resolver, ctx := newTestResolver(t, server)
+
+	result, err := resolver.UpdateUserACL(ctx, model.UpdateUserACLInput{
+		Email:             "user@example.com",
+		Permissions:       []*model.PermissionInput{{Table: "LoanInfo", Column: aws.String("*"), Action: "read"}},
+		ExpectedVersion:   aws.Int32(2),
+		ExpectedUpdatedAt: aws.String("2024-05-01T10:00:00Z"), // Rounded to the second
+	})
+	if err != nil {
+		t.Fatalf("UpdateUserACL: %v", err)
+	}
+	if result.Success || !result.Conflict {
+		t.Fatalf("UpdateUserACL = %+v, want a conflict", result)
+	}
+	if result.Record == nil || result.Record.Version != 2 || result.Record.UpdatedAt != "2024-05-01T10:00:00.123456789Z" {
+		t.Errorf("conflict record = %+v, want the stored version 2", result.Record)
+	}
+	if writes := len(server.Requests("TransactWriteItems")); writes != 0 {
+		t.Errorf("ran %d writes, want none", writes)
+	}
+}
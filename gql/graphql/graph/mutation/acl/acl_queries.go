@@ -1,12 +1,20 @@
 package acl
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"ssot/gql/graphql/graph/model"
+	"ssot/gql/graphql/internal/acl"
 	"ssot/gql/graphql/internal/services"
+	"strings"
+	"time"
 )
 
+// maxExpiringWithinDays bounds the window of the expiringGrants query to ten years
+const maxExpiringWithinDays = 3650
+
 // ACLQueryResolver handles ACL-related queries
 type ACLQueryResolver struct {
 	ServiceManager *services.ServiceManager
@@ -43,3 +51,175 @@ func (r *ACLQueryResolver) SsotReportsAdministratorConfiguration(ctx context.Con
 		ListACLRecords: gqlRecords,
 	}, nil
 }
+
+// ExpiringGrants lists the grants that end within the given number of days, soonest first
+func (r *ACLQueryResolver) ExpiringGrants(ctx context.Context, withinDays int32) ([]*model.ExpiringGrant, error) {
+	// Check admin access
+	if err := r.ServiceManager.ACLMiddleware.RequireAdminAccess(ctx); err != nil {
+		return nil, fmt.Errorf("access denied: %v", err)
+	}
+
+	if withinDays < 0 || withinDays > maxExpiringWithinDays {
+		return nil, fmt.Errorf("withinDays must be between 0 and %d, got %d", maxExpiringWithinDays, withinDays)
+	}
+
+	grants, err := r.ServiceManager.ACLService.ListExpiringGrants(ctx, time.Duration(withinDays)*24*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expiring grants: %v", err)
+	}
+
+	gqlGrants := make([]*model.ExpiringGrant, 0, len(grants))
+	for _, grant := range grants {
+		gqlGrants = append(gqlGrants, &model.ExpiringGrant{
+			PrincipalID: grant.PrincipalID,
+			Kind:        model.GrantKind(strings.ToUpper(string(grant.Kind))),
+			Name:        grant.Name,
+			ValidUntil:  grant.ValidUntil.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return gqlGrants, nil
+}
+
+// ACLAuditLog lists the changes made by the ACL mutations that match the filter, newest first
+func (r *ACLQueryResolver) ACLAuditLog(ctx context.Context, filter *model.ACLAuditFilter, limit int32) ([]*model.ACLAuditEntry, error) {
+	// Check admin access
+	if err := r.ServiceManager.ACLMiddleware.RequireAdminAccess(ctx); err != nil {
+		return nil, fmt.Errorf("access denied: %v", err)
+	}
+
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be positive, got %d", limit)
+	}
+
+	auditFilter := acl.AuditFilter{Limit: int(limit)}
+	if filter != nil {
+		if filter.PrincipalID != nil {
+			auditFilter.PrincipalID = *filter.PrincipalID
+		}
+		if filter.Actor != nil {
+			auditFilter.Actor = *filter.Actor
+		}
+		if filter.Operation != nil {
+			auditFilter.Operation = acl.AuditOperation(strings.ToLower(string(*filter.Operation)))
+		}
+		for _, bound := range []struct {
+			name   string
+			value  *string
+			target *time.Time
+		}{
+			{"from", filter.From, &auditFilter.From},
+			{"until", filter.Until, &auditFilter.Until},
+		} {
+			if bound.value == nil {
+				continue
+			}
+			parsed, err := time.Parse(time.RFC3339, *bound.value)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be an RFC 3339 timestamp: %v", bound.name, err)
+			}
+			*bound.target = parsed
+		}
+	}
+
+	entries, err := r.ServiceManager.ACLService.ListAuditEntries(ctx, auditFilter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit entries: %v", err)
+	}
+
+	gqlEntries := make([]*model.ACLAuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		changes := make([]*model.ACLAuditChange, 0, len(entry.Changes))
+		for _, change := range entry.Changes {
+			gqlChange := &model.ACLAuditChange{Path: change.Path}
+			if change.Before != "" {
+				gqlChange.Before = &change.Before
+			}
+			if change.After != "" {
+				gqlChange.After = &change.After
+			}
+			changes = append(changes, gqlChange)
+		}
+
+		gqlEntries = append(gqlEntries, &model.ACLAuditEntry{
+			EntryID:     entry.EntryID,
+			PrincipalID: entry.PrincipalID,
+			Actor:       entry.Actor,
+			Timestamp:   entry.Timestamp.UTC().Format(time.RFC3339Nano),
+			Operation:   model.ACLAuditOperation(strings.ToUpper(string(entry.Operation))),
+			Version:     int32(entry.Version),
+			Changes:     changes,
+		})
+	}
+
+	return gqlEntries, nil
+}
+
+// ACLRecordHistory lists every version of a user or group record, newest first
+func (r *ACLQueryResolver) ACLRecordHistory(ctx context.Context, principalID string) ([]*model.ACLRecordVersion, error) {
+	// Check admin access
+	if err := r.ServiceManager.ACLMiddleware.RequireAdminAccess(ctx); err != nil {
+		return nil, fmt.Errorf("access denied: %v", err)
+	}
+
+	versions, err := r.ServiceManager.ACLService.ListRecordVersions(ctx, principalID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ACL record versions: %v", err)
+	}
+
+	gqlVersions := make([]*model.ACLRecordVersion, 0, len(versions))
+	for _, version := range versions {
+		gqlVersion := &model.ACLRecordVersion{
+			PrincipalID: version.PrincipalID,
+			Version:     int32(version.Version),
+			CreatedAt:   version.CreatedAt.UTC().Format(time.RFC3339Nano),
+		}
+		if !version.Deleted() {
+			gqlVersion.Record = convertACLRecordToGraphQL(version.Record)
+		}
+		if version.Actor != "" {
+			gqlVersion.Actor = &version.Actor
+		}
+		if version.Operation != "" {
+			operation := model.ACLAuditOperation(strings.ToUpper(string(version.Operation)))
+			gqlVersion.Operation = &operation
+		}
+		gqlVersions = append(gqlVersions, gqlVersion)
+	}
+
+	return gqlVersions, nil
+}
+
+// aclFileContentTypes are the media types of exported ACL files, by format
+var aclFileContentTypes = map[acl.ACLFileFormat]string{
+	acl.FormatJSON: "application/json",
+	acl.FormatCSV:  "text/csv",
+	acl.FormatXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+// ExportACL renders every user and group record as an ACL file, which importACL reads back
+func (r *ACLQueryResolver) ExportACL(ctx context.Context, format model.ACLFileFormat) (*model.ACLExport, error) {
+	// Check admin access
+	if err := r.ServiceManager.ACLMiddleware.RequireAdminAccess(ctx); err != nil {
+		return nil, fmt.Errorf("access denied: %v", err)
+	}
+
+	rows, err := r.ServiceManager.ACLService.ExportRows(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ACL records: %v", err)
+	}
+
+	fileFormat := acl.ACLFileFormat(strings.ToLower(string(format)))
+	var content bytes.Buffer
+	if err := acl.WriteACLFile(&content, fileFormat, rows); err != nil {
+		return nil, fmt.Errorf("failed to write ACL file: %v", err)
+	}
+
+	return &model.ACLExport{
+		Format:      format,
+		Filename:    fmt.Sprintf("acl-%s.%s", time.Now().UTC().Format("2006-01-02"), fileFormat),
+		ContentType: aclFileContentTypes[fileFormat],
+		Content:     base64.StdEncoding.EncodeToString(content.Bytes()),
+		RowCount:    int32(len(rows)),
+	}, nil
+}
@@ -10,10 +10,24 @@ import (
 	"ssot/gql/graphql/graph/model"
 	"ssot/gql/graphql/graph/services"
 	"ssot/gql/graphql/internal/auth/middleware"
+	"ssot/gql/graphql/internal/loaders"
+
+	"github.com/99designs/gqlgen/graphql"
 )
 
+// LoanInfo is the resolver for the loanInfo field.
+func (r *loanCashFlowResolver) LoanInfo(ctx context.Context, obj *model.LoanCashFlow) (*model.LoanInfo, error) {
+	// The loan code is only decoded for callers who may read it
+	if obj.LoanCode == "" {
+		return nil, nil
+	}
+
+	// Batched with the other rows of the response; the loader applies LoanInfo permissions and field filters
+	return loaders.For(ctx, r.ServiceManager).LoanInfo.Load(ctx, obj.LoanCode)
+}
+
 // ByLoanCode is the resolver for the byLoanCode field.
-func (r *loanCashFlowsResolver) ByLoanCode(ctx context.Context, obj *model.LoanCashFlows, loanCode []*string, endDate *string) ([]*model.LoanCashFlow, error) {
+func (r *loanCashFlowsResolver) ByLoanCode(ctx context.Context, obj *model.LoanCashFlows, loanCode []*string, endDate *model.Date, startDate *model.Date, glPeriodStart *model.Date, glPeriodEnd *model.Date, order *model.SortOrder, latest *int32, filter *model.RowFilterInput, sort []*model.LoanCashFlowSort) ([]*model.LoanCashFlow, error) {
 	// Check authentication
 	_, err := middleware.GetUserFromContext(ctx)
 	if err != nil {
@@ -23,21 +37,73 @@ func (r *loanCashFlowsResolver) ByLoanCode(ctx context.Context, obj *model.LoanC
 	// Get column-level permissions using flexible ACL check (either ACL or scope check passes)
 	// This determines which columns the user can access
 	columnPermissions, err := r.ServiceManager.ACLMiddleware.GetColumnPermissionsFlexible(
-		ctx, "LoanCashFlow", "ssot:gql:loancashflow:read", services.AllLoanCashFlowColumns)
+		ctx, "LoanCashFlow", "ssot:gql:loancashflow:read", services.LoanCashFlowColumns.ACLColumns())
 	if err != nil {
 		return nil, err
 	}
 
+	// Date ranges, order and latest-N become the key condition on the postdate#maxHmy sort key
+	query, err := services.NewLoanCashFlowQuery(startDate, endDate, glPeriodStart, glPeriodEnd, order != nil && *order == model.SortOrderDesc, latest)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Filter, err = services.NewRowFilter(services.LoanCashFlowColumns, filter, columnPermissions); err != nil {
+		return nil, err
+	}
+	if query.Sort, err = services.NewSortFields(services.LoanCashFlowColumns, sort, columnPermissions); err != nil {
+		return nil, err
+	}
+
+	// Only read the selected columns the caller may see
+	query.Columns = services.LoanCashFlowColumns.Projection(services.SelectedFields(ctx), columnPermissions)
+
 	// Get field filters for array-level filtering
 	fieldFilters, err := r.ServiceManager.ACLMiddleware.GetFieldFilters(ctx)
 	if err != nil {
 		// If field filters fail, continue with column-only filtering
 		log.Printf("Warning: failed to get field filters: %v\n", err)
-		return r.ServiceManager.LoanCashFlowService.GetByLoanCodesWithEndDate(ctx, loanCode, endDate, columnPermissions)
+		return r.ServiceManager.LoanCashFlowService.GetByLoanCodesWithQuery(ctx, loanCode, query, columnPermissions)
+	}
+
+	// Use the enhanced service method with field filtering and the date range query
+	return r.ServiceManager.LoanCashFlowService.GetByLoanCodesWithQueryAndFieldFilters(ctx, loanCode, query, columnPermissions, fieldFilters)
+}
+
+// ByLoanCodeConnection is the resolver for the byLoanCodeConnection field.
+func (r *loanCashFlowsResolver) ByLoanCodeConnection(ctx context.Context, obj *model.LoanCashFlows, loanCode []*string, endDate *model.Date, startDate *model.Date, glPeriodStart *model.Date, glPeriodEnd *model.Date, order *model.SortOrder, filter *model.RowFilterInput, first *int32, after *string) (*model.LoanCashFlowConnection, error) {
+	// Check authentication
+	_, err := middleware.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	// Use the enhanced service method with field filtering and end date
-	return r.ServiceManager.LoanCashFlowService.GetByLoanCodesWithEndDateAndFieldFilters(ctx, loanCode, endDate, columnPermissions, fieldFilters)
+	// Get column-level permissions using flexible ACL check (either ACL or scope check passes)
+	columnPermissions, err := r.ServiceManager.ACLMiddleware.GetColumnPermissionsFlexible(
+		ctx, "LoanCashFlow", "ssot:gql:loancashflow:read", services.LoanCashFlowColumns.ACLColumns())
+	if err != nil {
+		return nil, err
+	}
+
+	// Field filters restrict which rows may be returned, so the page can't be served without them
+	fieldFilters, err := r.ServiceManager.ACLMiddleware.GetFieldFilters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := services.NewLoanCashFlowQuery(startDate, endDate, glPeriodStart, glPeriodEnd, order != nil && *order == model.SortOrderDesc, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Filter, err = services.NewRowFilter(services.LoanCashFlowColumns, filter, columnPermissions); err != nil {
+		return nil, err
+	}
+
+	// Only read the selected node columns the caller may see
+	query.Columns = services.LoanCashFlowColumns.Projection(services.SelectedFields(ctx, "edges", "node"), columnPermissions)
+
+	return r.ServiceManager.LoanCashFlowService.GetConnectionByLoanCodes(ctx, loanCode, query, first, after, columnPermissions, fieldFilters)
 }
 
 // AddUserACL is the resolver for the addUserACL field.
@@ -70,6 +136,96 @@ func (r *mutationResolver) DeleteGroupACL(ctx context.Context, groupName string)
 	return r.ACLMutations.DeleteGroupACL(ctx, groupName)
 }
 
+// RollbackACL is the resolver for the rollbackACL field.
+func (r *mutationResolver) RollbackACL(ctx context.Context, principalID string, version int32) (*model.ACLMutationResult, error) {
+	return r.ACLMutations.RollbackACL(ctx, principalID, version)
+}
+
+// ImportACL is the resolver for the importACL field.
+func (r *mutationResolver) ImportACL(ctx context.Context, file graphql.Upload, format *model.ACLFileFormat, mode model.ACLImportMode, dryRun bool) (*model.ACLImportResult, error) {
+	return r.ACLMutations.ImportACL(ctx, file, format, mode, dryRun)
+}
+
+// ByPropertyCode is the resolver for the byPropertyCode field.
+func (r *propertiesResolver) ByPropertyCode(ctx context.Context, obj *model.Properties, propertyCode []string) ([]*model.Property, error) {
+	// Check authentication
+	_, err := middleware.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Properties are read from pbi-loaninfo, so LoanInfo permissions apply
+	columnPermissions, err := r.ServiceManager.ACLMiddleware.GetColumnPermissionsFlexible(
+		ctx, "LoanInfo", "ssot:gql:loaninfo:read", services.LoanInfoColumns.ACLColumns())
+	if err != nil {
+		return nil, err
+	}
+
+	// Field filters decide which properties and loans are listed, so they can't be skipped
+	fieldFilters, err := r.ServiceManager.ACLMiddleware.GetFieldFilters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.ServiceManager.PropertyService.GetByPropertyCodes(ctx, propertyCode, columnPermissions, fieldFilters)
+}
+
+// Loans is the resolver for the loans field.
+func (r *propertyResolver) Loans(ctx context.Context, obj *model.Property) ([]*model.LoanInfo, error) {
+	// Batched with the loans of the other properties in the response
+	loaded, err := loaders.For(ctx, r.ServiceManager).LoanInfo.LoadMany(ctx, obj.LoanCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	loans := []*model.LoanInfo{}
+	for _, loan := range loaded {
+		if loan != nil {
+			loans = append(loans, loan)
+		}
+	}
+	return loans, nil
+}
+
+// CashFlows is the resolver for the cashFlows field.
+func (r *propertyResolver) CashFlows(ctx context.Context, obj *model.Property, startDate *model.Date, endDate *model.Date, glPeriodStart *model.Date, glPeriodEnd *model.Date, order *model.SortOrder, filter *model.RowFilterInput, sort []*model.LoanCashFlowSort) ([]*model.LoanCashFlow, error) {
+	// An empty loan code list would read every loan
+	if len(obj.LoanCodes) == 0 {
+		return []*model.LoanCashFlow{}, nil
+	}
+
+	columnPermissions, err := r.ServiceManager.ACLMiddleware.GetColumnPermissionsFlexible(
+		ctx, "LoanCashFlow", "ssot:gql:loancashflow:read", services.LoanCashFlowColumns.ACLColumns())
+	if err != nil {
+		return nil, err
+	}
+
+	// Field filters restrict which cash flows may be returned, so the query can't run without them
+	fieldFilters, err := r.ServiceManager.ACLMiddleware.GetFieldFilters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := services.NewLoanCashFlowQuery(startDate, endDate, glPeriodStart, glPeriodEnd, order != nil && *order == model.SortOrderDesc, nil)
+	if err != nil {
+		return nil, err
+	}
+	if query.Filter, err = services.NewRowFilter(services.LoanCashFlowColumns, filter, columnPermissions); err != nil {
+		return nil, err
+	}
+	if query.Sort, err = services.NewSortFields(services.LoanCashFlowColumns, sort, columnPermissions); err != nil {
+		return nil, err
+	}
+	query.Columns = services.LoanCashFlowColumns.Projection(services.SelectedFields(ctx), columnPermissions)
+
+	loanCodes := make([]*string, len(obj.LoanCodes))
+	for i := range obj.LoanCodes {
+		loanCodes[i] = &obj.LoanCodes[i]
+	}
+
+	return r.ServiceManager.LoanCashFlowService.GetByLoanCodesWithQueryAndFieldFilters(ctx, loanCodes, query, columnPermissions, fieldFilters)
+}
+
 // LoanCashFlow is the resolver for the loanCashFlow field.
 func (r *queryResolver) LoanCashFlow(ctx context.Context) (*model.LoanCashFlows, error) {
 	// Check authentication
@@ -81,20 +237,139 @@ func (r *queryResolver) LoanCashFlow(ctx context.Context) (*model.LoanCashFlows,
 	return &model.LoanCashFlows{}, nil
 }
 
+// LoanCashFlowAggregate is the resolver for the loanCashFlowAggregate field.
+func (r *queryResolver) LoanCashFlowAggregate(ctx context.Context, groupBy []string, metrics []*model.LoanCashFlowMetricInput, filter *model.LoanCashFlowAggregateFilter) ([]*model.LoanCashFlowAggregateGroup, error) {
+	// Check authentication
+	_, err := middleware.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Aggregates follow the same column permissions and field filters as byLoanCode
+	columnPermissions, err := r.ServiceManager.ACLMiddleware.GetColumnPermissionsFlexible(
+		ctx, "LoanCashFlow", "ssot:gql:loancashflow:read", services.LoanCashFlowColumns.ACLColumns())
+	if err != nil {
+		return nil, err
+	}
+
+	// Rows hidden by field filters must not be counted, so aggregates can't be computed without them
+	fieldFilters, err := r.ServiceManager.ACLMiddleware.GetFieldFilters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter == nil {
+		filter = &model.LoanCashFlowAggregateFilter{}
+	}
+
+	query, err := services.NewLoanCashFlowQuery(filter.StartDate, filter.EndDate, filter.GlPeriodStart, filter.GlPeriodEnd, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	if query.Filter, err = services.NewRowFilter(services.LoanCashFlowColumns, filter.Where, columnPermissions); err != nil {
+		return nil, err
+	}
+
+	var loanCodes []*string
+	for _, loanCode := range filter.LoanCode {
+		loanCodes = append(loanCodes, &loanCode)
+	}
+
+	return r.ServiceManager.LoanCashFlowService.AggregateLoanCashFlows(ctx, loanCodes, query, groupBy, metrics, columnPermissions, fieldFilters)
+}
+
+// LoanInfo is the resolver for the loanInfo field.
+func (r *queryResolver) LoanInfo(ctx context.Context, loanCode []string, filter *model.RowFilterInput) ([]*model.LoanInfo, error) {
+	// Check authentication
+	_, err := middleware.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	columnPermissions, err := r.ServiceManager.ACLMiddleware.GetColumnPermissionsFlexible(
+		ctx, "LoanInfo", "ssot:gql:loaninfo:read", services.LoanInfoColumns.ACLColumns())
+	if err != nil {
+		return nil, err
+	}
+
+	// Field filters restrict which loans may be returned, so the query can't run without them
+	fieldFilters, err := r.ServiceManager.ACLMiddleware.GetFieldFilters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rowFilter, err := services.NewRowFilter(services.LoanInfoColumns, filter, columnPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := services.LoanInfoColumns.Projection(services.SelectedFields(ctx), columnPermissions)
+	return r.ServiceManager.LoanInfoService.GetLoanInfos(ctx, loanCode, rowFilter, columns, columnPermissions, fieldFilters)
+}
+
+// Property is the resolver for the property field.
+func (r *queryResolver) Property(ctx context.Context) (*model.Properties, error) {
+	// Check authentication
+	_, err := middleware.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Properties{}, nil
+}
+
 // SsotReportsAdministratorConfiguration is the resolver for the ssotReportsAdministratorConfiguration field.
 func (r *queryResolver) SsotReportsAdministratorConfiguration(ctx context.Context) (*model.SsotReportsAdministratorConfiguration, error) {
 	return r.ACLQueries.SsotReportsAdministratorConfiguration(ctx)
 }
 
+// ExpiringGrants is the resolver for the expiringGrants field.
+func (r *ssotReportsAdministratorConfigurationResolver) ExpiringGrants(ctx context.Context, obj *model.SsotReportsAdministratorConfiguration, withinDays int32) ([]*model.ExpiringGrant, error) {
+	return r.ACLQueries.ExpiringGrants(ctx, withinDays)
+}
+
+// ACLAuditLog is the resolver for the aclAuditLog field.
+func (r *ssotReportsAdministratorConfigurationResolver) ACLAuditLog(ctx context.Context, obj *model.SsotReportsAdministratorConfiguration, filter *model.ACLAuditFilter, limit int32) ([]*model.ACLAuditEntry, error) {
+	return r.ACLQueries.ACLAuditLog(ctx, filter, limit)
+}
+
+// ACLRecordHistory is the resolver for the aclRecordHistory field.
+func (r *ssotReportsAdministratorConfigurationResolver) ACLRecordHistory(ctx context.Context, obj *model.SsotReportsAdministratorConfiguration, principalID string) ([]*model.ACLRecordVersion, error) {
+	return r.ACLQueries.ACLRecordHistory(ctx, principalID)
+}
+
+// ExportACL is the resolver for the exportACL field.
+func (r *ssotReportsAdministratorConfigurationResolver) ExportACL(ctx context.Context, obj *model.SsotReportsAdministratorConfiguration, format model.ACLFileFormat) (*model.ACLExport, error) {
+	return r.ACLQueries.ExportACL(ctx, format)
+}
+
+// LoanCashFlow returns LoanCashFlowResolver implementation.
+func (r *Resolver) LoanCashFlow() LoanCashFlowResolver { return &loanCashFlowResolver{r} }
+
 // LoanCashFlows returns LoanCashFlowsResolver implementation.
 func (r *Resolver) LoanCashFlows() LoanCashFlowsResolver { return &loanCashFlowsResolver{r} }
 
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
+// Properties returns PropertiesResolver implementation.
+func (r *Resolver) Properties() PropertiesResolver { return &propertiesResolver{r} }
+
+// Property returns PropertyResolver implementation.
+func (r *Resolver) Property() PropertyResolver { return &propertyResolver{r} }
+
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// SsotReportsAdministratorConfiguration returns SsotReportsAdministratorConfigurationResolver implementation.
+func (r *Resolver) SsotReportsAdministratorConfiguration() SsotReportsAdministratorConfigurationResolver {
+	return &ssotReportsAdministratorConfigurationResolver{r}
+}
+
+type loanCashFlowResolver struct{ *Resolver }
 type loanCashFlowsResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
+type propertiesResolver struct{ *Resolver }
+type propertyResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type ssotReportsAdministratorConfigurationResolver struct{ *Resolver }
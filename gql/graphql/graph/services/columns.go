@@ -0,0 +1,303 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"ssot/gql/graphql/graph/model"
+	"ssot/gql/graphql/internal/acl"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// ColumnType is how a column is stored in DynamoDB
+type ColumnType string
+
+const (
+	ColumnTypeString     ColumnType = "String"     // Stored as S
+	ColumnTypeNumber     ColumnType = "Number"     // Stored as N
+	ColumnTypeDate       ColumnType = "Date"       // Stored as S in the source sheet's format, compared as a date
+	ColumnTypeDateTime   ColumnType = "DateTime"   // Stored as S in sortable yyyy-MM-ddTHH:mm:ss form
+	ColumnTypeNumberText ColumnType = "NumberText" // Stored as S holding a number, compared as a number
+)
+
+// Column maps one DynamoDB attribute of a dataset to its GraphQL field and ACL column name
+type Column[T any] struct {
+	Attribute string           // DynamoDB attribute name
+	Field     string           // GraphQL field name
+	Type      ColumnType       // How the attribute is stored
+	ACLName   string           // Column name used by ACL rules and field filters (defaults to Attribute)
+	Target    func(row *T) any // Pointer to the model field the attribute is decoded into
+}
+
+// Text returns the column's value on row as a string, or nil if it is unset
+func (c Column[T]) Text(row *T) *string {
+	switch t := c.Target(row).(type) {
+	case *string:
+		return t
+	case **string:
+		return *t
+	case **model.Decimal:
+		if *t == nil {
+			return nil
+		}
+		text := (*t).String()
+		return &text
+	case **model.Date:
+		if *t == nil {
+			return nil
+		}
+		text := (*t).String()
+		return &text
+	case **model.DateTime:
+		if *t == nil {
+			return nil
+		}
+		text := (*t).String()
+		return &text
+	default:
+		return nil
+	}
+}
+
+// Number returns the column's value on row as a number, or nil if it is unset or not numeric
+func (c Column[T]) Number(row *T) *model.Decimal {
+	if t, ok := c.Target(row).(**model.Decimal); ok {
+		return *t
+	}
+	return nil
+}
+
+// ColumnRegistry describes every column of a dataset and decodes its DynamoDB items
+type ColumnRegistry[T any] struct {
+	table       string
+	columns     []Column[T]
+	byField     map[string]int
+	byAttribute map[string]int
+	byACLName   map[string]int
+	byFoldedACL map[string]int // byACLName keyed by the lower-case name
+}
+
+// NewColumnRegistry creates a registry for the ACL table name and its columns.
+// It panics on duplicate names, since registries are declared as package variables.
+func NewColumnRegistry[T any](table string, columns ...Column[T]) *ColumnRegistry[T] {
+	r := &ColumnRegistry[T]{
+		table:       table,
+		byField:     make(map[string]int, len(columns)),
+		byAttribute: make(map[string]int, len(columns)),
+		byACLName:   make(map[string]int, len(columns)),
+		byFoldedACL: make(map[string]int, len(columns)),
+	}
+
+	for _, column := range columns {
+		if column.ACLName == "" {
+			column.ACLName = column.Attribute
+		}
+		if _, exists := r.byAttribute[column.Attribute]; exists {
+			panic(fmt.Sprintf("duplicate column attribute %s in %s registry", column.Attribute, table))
+		}
+		if _, exists := r.byField[column.Field]; exists {
+			panic(fmt.Sprintf("duplicate column field %s in %s registry", column.Field, table))
+		}
+		if _, exists := r.byFoldedACL[strings.ToLower(column.ACLName)]; exists {
+			panic(fmt.Sprintf("duplicate column ACL name %s in %s registry", column.ACLName, table))
+		}
+
+		r.byField[column.Field] = len(r.columns)
+		r.byAttribute[column.Attribute] = len(r.columns)
+		r.byACLName[column.ACLName] = len(r.columns)
+		r.byFoldedACL[strings.ToLower(column.ACLName)] = len(r.columns)
+		r.columns = append(r.columns, column)
+	}
+
+	return r
+}
+
+// Table returns the ACL table name of the dataset
+func (r *ColumnRegistry[T]) Table() string {
+	return r.table
+}
+
+// Columns returns every registered column in declaration order
+func (r *ColumnRegistry[T]) Columns() []Column[T] {
+	return r.columns
+}
+
+// ACLColumns returns the ACL column names of every column, for column permission checks
+func (r *ColumnRegistry[T]) ACLColumns() []string {
+	names := make([]string, len(r.columns))
+	for i, column := range r.columns {
+		names[i] = column.ACLName
+	}
+	return names
+}
+
+// ByField looks up a column by its GraphQL field name
+func (r *ColumnRegistry[T]) ByField(field string) (Column[T], bool) {
+	return r.lookup(r.byField, field)
+}
+
+// ByAttribute looks up a column by its DynamoDB attribute name
+func (r *ColumnRegistry[T]) ByAttribute(attribute string) (Column[T], bool) {
+	return r.lookup(r.byAttribute, attribute)
+}
+
+// ByACLName looks up a column by the name ACL rules use for it
+func (r *ColumnRegistry[T]) ByACLName(name string) (Column[T], bool) {
+	return r.lookup(r.byACLName, name)
+}
+
+// byACLNameFold looks up a column by its ACL name ignoring case, as field filter keys written
+// before the names were settled use other spellings (e.g. "LoanCode")
+func (r *ColumnRegistry[T]) byACLNameFold(name string) (Column[T], bool) {
+	return r.lookup(r.byFoldedACL, strings.ToLower(name))
+}
+
+// Readable looks up a column by its GraphQL field name, failing if it is unknown or the
+// caller is not allowed to read it
+func (r *ColumnRegistry[T]) Readable(field string, columnPermissions *acl.ColumnPermissions) (Column[T], error) {
+	column, ok := r.ByField(field)
+	if !ok {
+		return column, fmt.Errorf("unknown field %s", field)
+	}
+	if !columnPermissions.IsAllowed(column.ACLName) {
+		return column, fmt.Errorf("access denied to column %s", column.ACLName)
+	}
+	return column, nil
+}
+
+func (r *ColumnRegistry[T]) lookup(index map[string]int, name string) (Column[T], bool) {
+	i, ok := index[name]
+	if !ok {
+		return Column[T]{}, false
+	}
+	return r.columns[i], true
+}
+
+// Decode builds a row from a DynamoDB item, populating only the columns the caller may see
+func (r *ColumnRegistry[T]) Decode(item map[string]types.AttributeValue, columnPermissions *acl.ColumnPermissions) (*T, error) {
+	row := new(T)
+
+	for _, column := range r.columns {
+		if !columnPermissions.IsAllowed(column.ACLName) {
+			continue
+		}
+		value, ok := item[column.Attribute]
+		if !ok {
+			continue
+		}
+		if err := decodeAttribute(value, column.Target(row)); err != nil {
+			return nil, fmt.Errorf("column %s: %w", column.Attribute, err)
+		}
+	}
+
+	return row, nil
+}
+
+// Projection returns the attributes behind the selected GraphQL fields that the caller may see.
+// A nil selection stays nil, which reads every attribute.
+func (r *ColumnRegistry[T]) Projection(fields []string, columnPermissions *acl.ColumnPermissions) []string {
+	if fields == nil {
+		return nil
+	}
+
+	attributes := []string{}
+	for _, field := range fields {
+		if column, ok := r.ByField(field); ok && columnPermissions.IsAllowed(column.ACLName) {
+			attributes = append(attributes, column.Attribute)
+		}
+	}
+
+	return attributes
+}
+
+// decodeAttribute stores a DynamoDB value in the model field target points to.
+// Values stored with an unexpected type are left unset, as the source sheets are not always
+// consistent, and so are blank values. Numbers and dates that don't parse are left unset too,
+// and logged so bad source data shows up instead of reading as missing.
+func decodeAttribute(value types.AttributeValue, target any) error {
+	switch t := target.(type) {
+	case *string:
+		if s, ok := value.(*types.AttributeValueMemberS); ok {
+			*t = s.Value
+		}
+	case **string:
+		if s, ok := value.(*types.AttributeValueMemberS); ok {
+			*t = &s.Value
+		}
+	case **model.Decimal:
+		var text string
+		switch v := value.(type) {
+		case *types.AttributeValueMemberN:
+			text = v.Value
+		case *types.AttributeValueMemberS:
+			text = v.Value
+		default:
+			return nil
+		}
+		if strings.TrimSpace(text) == "" {
+			return nil
+		}
+		val, err := model.ParseDecimal(text)
+		if err != nil {
+			log.Printf("Warning: leaving unparseable number %q unset: %v\n", text, err)
+			return nil
+		}
+		*t = &val
+	case **model.Date:
+		if s, ok := value.(*types.AttributeValueMemberS); ok && strings.TrimSpace(s.Value) != "" {
+			parsed, ok := parseDateValue(s.Value, storedDateLayouts)
+			if !ok {
+				log.Printf("Warning: leaving unparseable date %q unset\n", s.Value)
+				return nil
+			}
+			date := model.Date(parsed)
+			*t = &date
+		}
+	case **model.DateTime:
+		if s, ok := value.(*types.AttributeValueMemberS); ok && strings.TrimSpace(s.Value) != "" {
+			parsed, ok := parseDateValue(s.Value, storedDateLayouts)
+			if !ok {
+				log.Printf("Warning: leaving unparseable date %q unset\n", s.Value)
+				return nil
+			}
+			dateTime := model.DateTime(parsed)
+			*t = &dateTime
+		}
+	default:
+		return fmt.Errorf("unsupported target type %T", target)
+	}
+
+	return nil
+}
+
+// SelectedFields returns the GraphQL fields selected under the current resolver. path descends
+// into nested selections first, e.g. "edges", "node" for a connection. It returns nil outside
+// of a resolver, which callers treat as every field.
+func SelectedFields(ctx context.Context, path ...string) []string {
+	if graphql.GetFieldContext(ctx) == nil || !graphql.HasOperationContext(ctx) {
+		return nil
+	}
+
+	operationContext := graphql.GetOperationContext(ctx)
+	fields := graphql.CollectFieldsCtx(ctx, nil)
+	for _, name := range path {
+		var nested []graphql.CollectedField
+		for _, field := range fields {
+			if field.Name == name {
+				nested = append(nested, graphql.CollectFields(operationContext, field.Selections, nil)...)
+			}
+		}
+		fields = nested
+	}
+
+	names := []string{}
+	for _, field := range fields {
+		names = append(names, field.Name)
+	}
+
+	return names
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"testing"
+
+	"ssot/gql/graphql/graph/model"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+func TestDecodeAttributeUnparseableValues(t *testing.T) {
+	var logged bytes.Buffer
+	output := log.Writer()
+	log.SetOutput(&logged)
+	t.Cleanup(func() { log.SetOutput(output) })
+
+	tests := []struct {
+		name    string
+		value   types.AttributeValue
+		target  func() (any, func() bool)
+		wantLog bool
+	}{
+		{
+			name:  "date",
+			value: &types.AttributeValueMemberS{Value: "31/31/2024"},
+			target: func() (any, func() bool) {
+				var date *model.Date
+				return &date, func() bool { return date != nil }
+			},
+			wantLog: true,
+		},
+		{
+			name:  "date time",
+			value: &types.AttributeValueMemberS{Value: "yesterday"},
+			target: func() (any, func() bool) {
+				var dateTime *model.DateTime
+				return &dateTime, func() bool { return dateTime != nil }
+			},
+			wantLog: true,
+		},
+		{
+			name:  "number",
+			value: &types.AttributeValueMemberS{Value: "n/a"},
+			target: func() (any, func() bool) {
+				var number *model.Decimal
+				return &number, func() bool { return number != nil }
+			},
+			wantLog: true,
+		},
+		{
+			name:  "blank date",
+			value: &types.AttributeValueMemberS{Value: " "},
+			target: func() (any, func() bool) {
+				var date *model.Date
+				return &date, func() bool { return date != nil }
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logged.Reset()
+			target, set := tt.target()
+
+			if err := decodeAttribute(tt.value, target); err != nil {
+				t.Fatalf("decodeAttribute: %v", err)
+			}
+			if set() {
+				t.Error("unparseable value was decoded")
+			}
+			if got := strings.Contains(logged.String(), "Warning"); got != tt.wantLog {
+				t.Errorf("logged %q, want a warning: %v", logged.String(), tt.wantLog)
+			}
+		})
+	}
+}
@@ -3,7 +3,9 @@ package services
 import (
 	"context"
 	"fmt"
-	"time"
+	"slices"
+	"strings"
+	"sync"
 
 	"ssot/gql/graphql/graph/model"
 	"ssot/gql/graphql/internal/acl"
@@ -15,178 +17,246 @@ import (
 
 // GetByLoanCodesWithEndDate retrieves loan cash flows for multiple loan codes with optional end date filtering
 func (s *LoanCashFlowService) GetByLoanCodesWithEndDate(ctx context.Context, loanCodes []*string, endDate *string, columnPermissions *acl.ColumnPermissions) ([]*model.LoanCashFlow, error) {
-	// If loanCodes array is empty or nil, get all loans
-	if len(loanCodes) == 0 {
-		return s.GetAllLoansWithEndDate(ctx, endDate, columnPermissions)
+	query, err := endDateQuery(endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetByLoanCodesWithQuery(ctx, loanCodes, query, columnPermissions)
+}
+
+// GetByLoanCodesWithQuery retrieves loan cash flows for multiple loan codes, or all loans if the array is empty,
+// narrowed by the date range, filter, ordering and latest-rows settings of the query
+func (s *LoanCashFlowService) GetByLoanCodesWithQuery(ctx context.Context, loanCodes []*string, query LoanCashFlowQuery, columnPermissions *acl.ColumnPermissions) ([]*model.LoanCashFlow, error) {
+	loanCashFlows, err := s.readLoanCodes(ctx, loanCodes, query, columnPermissions)
+	if err != nil {
+		return nil, err
 	}
 
-	var allLoanCashFlows []*model.LoanCashFlow
+	sortRows(loanCashFlows, query.Sort)
+	return loanCashFlows, nil
+}
 
-	// Query for each loan code individually
+// readLoanCodes queries each loan code, or scans all loans if the array is empty
+func (s *LoanCashFlowService) readLoanCodes(ctx context.Context, loanCodes []*string, query LoanCashFlowQuery, columnPermissions *acl.ColumnPermissions) ([]*model.LoanCashFlow, error) {
+	var codes []string
 	for _, loanCodePtr := range loanCodes {
 		if loanCodePtr == nil {
 			continue // Skip nil loan codes
 		}
+		codes = append(codes, *loanCodePtr)
+	}
 
-		loanCode := *loanCodePtr
-		loanCashFlows, err := s.GetByLoanCodeWithEndDate(ctx, loanCode, endDate, columnPermissions)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get loan cash flows for loan code %s: %w", loanCode, err)
+	// If loanCodes array is empty or nil, a loanCode filter still avoids the scan
+	if len(loanCodes) == 0 {
+		codes = query.Filter.keyValues("loancode")
+		if len(codes) == 0 {
+			return s.GetAllLoansWithQuery(ctx, query, columnPermissions)
 		}
-
-		allLoanCashFlows = append(allLoanCashFlows, loanCashFlows...)
 	}
 
-	return allLoanCashFlows, nil
+	// Query the loan codes concurrently, keeping the requested order
+	return s.getByLoanCodesConcurrently(ctx, codes, func(ctx context.Context, loanCode string) ([]*model.LoanCashFlow, error) {
+		return s.GetByLoanCodeWithQuery(ctx, loanCode, query, columnPermissions)
+	})
 }
 
 // GetByLoanCodeWithEndDate retrieves loan cash flows for a single loan code with optional end date filtering
 func (s *LoanCashFlowService) GetByLoanCodeWithEndDate(ctx context.Context, loanCode string, endDate *string, columnPermissions *acl.ColumnPermissions) ([]*model.LoanCashFlow, error) {
-	input := &dynamodb.QueryInput{
-		TableName:              aws.String(s.tableName),
-		IndexName:              aws.String("loancode-postdate-maxHmy-index"),
-		KeyConditionExpression: aws.String("loancode = :loancodeVal"),
-		ExpressionAttributeValues: map[string]types.AttributeValue{
-			":loancodeVal": &types.AttributeValueMemberS{Value: loanCode},
-		},
-		ScanIndexForward: aws.Bool(true),
-	}
-
-	// Add date filtering if endDate is provided
-	if endDate != nil && *endDate != "" {
-		// Parse the date in MM/dd/yyyy format
-		parsedDate, err := time.Parse("01/02/2006", *endDate)
-		if err != nil {
-			return nil, fmt.Errorf("invalid date format, expected MM/dd/yyyy: %w", err)
-		}
+	query, err := endDateQuery(endDate)
+	if err != nil {
+		return nil, err
+	}
 
-		// Format to the expected DynamoDB date format (assuming it's stored as YYYY-MM-DD or similar)
-		// You may need to adjust this format based on how dates are stored in your DynamoDB
-		formattedDate := parsedDate.Format("2006-01-02")
+	return s.GetByLoanCodeWithQuery(ctx, loanCode, query, columnPermissions)
+}
 
-		// Add filter expression for postdate
-		input.FilterExpression = aws.String("postdate <= :endDateVal")
-		if input.ExpressionAttributeValues == nil {
-			input.ExpressionAttributeValues = make(map[string]types.AttributeValue)
-		}
-		input.ExpressionAttributeValues[":endDateVal"] = &types.AttributeValueMemberS{Value: formattedDate}
-	}
+// GetByLoanCodeWithQuery retrieves loan cash flows for a single loan code.
+// Post date bounds become a key condition on the sort key, so only matching rows are read.
+func (s *LoanCashFlowService) GetByLoanCodeWithQuery(ctx context.Context, loanCode string, query LoanCashFlowQuery, columnPermissions *acl.ColumnPermissions) ([]*model.LoanCashFlow, error) {
+	input := s.loanCodeQueryInput(loanCode, query)
 
-	result, err := s.client.Query(ctx, input)
+	items, err := s.readAll(ctx, newQueryIterator(s.client, input), "loancode:"+loanCode, query.accepts(true), query.Latest)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query DynamoDB: %w", err)
+		return nil, err
 	}
 
-	var loanCashFlows []*model.LoanCashFlow
-	for _, item := range result.Items {
-		loanCashFlow, err := s.itemToLoanCashFlowFiltered(item, columnPermissions)
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert DynamoDB item: %w", err)
-		}
-		loanCashFlows = append(loanCashFlows, loanCashFlow)
+	// Latest rows are read newest first; put them back in the requested order
+	if query.Latest > 0 && !query.Descending {
+		slices.Reverse(items)
 	}
 
-	return loanCashFlows, nil
+	return s.itemsToLoanCashFlows(items, columnPermissions)
+}
+
+// loanCodeQueryInput builds the index query for a single loan code, reading only the query's columns
+func (s *LoanCashFlowService) loanCodeQueryInput(loanCode string, query LoanCashFlowQuery) *dynamodb.QueryInput {
+	expr := newExpressionBuilder()
+
+	keyCondition := fmt.Sprintf("%s = %s", expr.name("loancode"), expr.value(&types.AttributeValueMemberS{Value: loanCode}))
+	if rangeCondition := query.dateRangeCondition(expr, sortKeyAttribute); rangeCondition != "" {
+		keyCondition += " AND " + rangeCondition
+	}
+
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(s.tableName),
+		IndexName:              aws.String(loanCodeIndexName),
+		KeyConditionExpression: aws.String(keyCondition),
+		ScanIndexForward:       aws.Bool(!query.newestFirst()),
+	}
+	if filter := query.filterExpression(expr, true); filter != "" {
+		input.FilterExpression = aws.String(filter)
+	}
+	if projection := query.projectionExpression(expr); projection != "" {
+		input.ProjectionExpression = aws.String(projection)
+	}
+	input.ExpressionAttributeNames = expr.attributeNames()
+	input.ExpressionAttributeValues = expr.attributeValues()
+
+	// Latest rows only need the first page of that size, unless rows are dropped in memory
+	if query.Latest > 0 {
+		input.Limit = aws.Int32(int32(query.Latest))
+	}
+
+	return input
 }
 
 // GetAllLoansWithEndDate retrieves all loan cash flows with optional end date filtering
 func (s *LoanCashFlowService) GetAllLoansWithEndDate(ctx context.Context, endDate *string, columnPermissions *acl.ColumnPermissions) ([]*model.LoanCashFlow, error) {
-	input := &dynamodb.ScanInput{
-		TableName: aws.String(s.tableName),
-		Limit:     aws.Int32(1000),
+	query, err := endDateQuery(endDate)
+	if err != nil {
+		return nil, err
 	}
 
-	// Add date filtering if endDate is provided
-	if endDate != nil && *endDate != "" {
-		// Parse the date in MM/dd/yyyy format
-		parsedDate, err := time.Parse("01/02/2006", *endDate)
-		if err != nil {
-			return nil, fmt.Errorf("invalid date format, expected MM/dd/yyyy: %w", err)
-		}
+	return s.GetAllLoansWithQuery(ctx, query, columnPermissions)
+}
 
-		// Format to the expected DynamoDB date format
-		formattedDate := parsedDate.Format("2006-01-02")
+// GetAllLoansWithQuery retrieves all loan cash flows, ordered by loan code and then post date
+func (s *LoanCashFlowService) GetAllLoansWithQuery(ctx context.Context, query LoanCashFlowQuery, columnPermissions *acl.ColumnPermissions) ([]*model.LoanCashFlow, error) {
+	items, err := s.scanAllSegments(ctx, query)
+	if err != nil {
+		return nil, err
+	}
 
-		// Add filter expression for postdate
-		input.FilterExpression = aws.String("postdate <= :endDateVal")
-		input.ExpressionAttributeValues = map[string]types.AttributeValue{
-			":endDateVal": &types.AttributeValueMemberS{Value: formattedDate},
+	sortByLoanAndSortKey(items, query.newestFirst())
+	if query.Latest > 0 {
+		items = keepLatestPerLoan(items, query.Latest)
+		if !query.Descending {
+			sortByLoanAndSortKey(items, false)
 		}
 	}
 
-	var loanCashFlows []*model.LoanCashFlow
-	var lastEvaluatedKey map[string]types.AttributeValue
+	return s.itemsToLoanCashFlows(items, columnPermissions)
+}
 
-	for {
-		result, err := s.client.Scan(ctx, input)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan loan cash flows: %w", err)
-		}
+// scanAllSegments reads the table as a parallel Scan split into the configured number of segments.
+// Segments are concatenated in segment order, so sorting the result is deterministic.
+// The item cap applies to the whole scan, and the first failing segment cancels the others.
+func (s *LoanCashFlowService) scanAllSegments(ctx context.Context, query LoanCashFlowQuery) ([]map[string]types.AttributeValue, error) {
+	if s.scanSegments <= 1 {
+		return s.readAll(ctx, newScanIterator(s.client, s.scanInput(query)), "scan:"+s.tableName, query.accepts(false), 0)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	budget := newItemBudget("scan:"+s.tableName, s.maxItemsPerQuery)
+	segments := make([][]map[string]types.AttributeValue, s.scanSegments)
+	var firstErr error
+	var failOnce sync.Once
 
-		for _, item := range result.Items {
-			loanCashFlow, err := s.itemToLoanCashFlowFiltered(item, columnPermissions)
+	var wg sync.WaitGroup
+	for segment := range s.scanSegments {
+		wg.Go(func() {
+			input := s.scanInput(query)
+			input.Segment = aws.Int32(int32(segment))
+			input.TotalSegments = aws.Int32(int32(s.scanSegments))
+
+			items, err := readWithin(ctx, newScanIterator(s.client, input), query.accepts(false), 0, budget)
 			if err != nil {
-				return nil, fmt.Errorf("failed to convert DynamoDB item: %w", err)
+				failOnce.Do(func() {
+					firstErr = fmt.Errorf("scan segment %d of %d: %w", segment, s.scanSegments, err)
+					cancel()
+				})
+				return
 			}
-			loanCashFlows = append(loanCashFlows, loanCashFlow)
-		}
+			segments[segment] = items
+		})
+	}
+	wg.Wait()
 
-		if len(result.LastEvaluatedKey) == 0 {
-			break
-		}
-		lastEvaluatedKey = result.LastEvaluatedKey
-		input.ExclusiveStartKey = lastEvaluatedKey
+	if firstErr != nil {
+		return nil, firstErr
 	}
 
-	return loanCashFlows, nil
+	var items []map[string]types.AttributeValue
+	for _, segmentItems := range segments {
+		items = append(items, segmentItems...)
+	}
+
+	return items, nil
 }
 
-// GetByLoanCodesWithEndDateAndFieldFilters retrieves loan cash flows for multiple loan codes with end date and field filtering
-func (s *LoanCashFlowService) GetByLoanCodesWithEndDateAndFieldFilters(ctx context.Context, loanCodes []*string, endDate *string, columnPermissions *acl.ColumnPermissions, fieldFilters map[string]acl.FieldFilter) ([]*model.LoanCashFlow, error) {
-	// First get the data with column and date filtering
-	loanCashFlows, err := s.GetByLoanCodesWithEndDate(ctx, loanCodes, endDate, columnPermissions)
-	if err != nil {
-		return nil, err
-	}
+// scanInput builds the full table scan; post date bounds and pushed-down filter conditions
+// become the filter expression and
+// the query's columns become the projection expression
+func (s *LoanCashFlowService) scanInput(query LoanCashFlowQuery) *dynamodb.ScanInput {
+	expr := newExpressionBuilder()
 
-	// Apply field filters
-	if len(fieldFilters) == 0 {
-		return loanCashFlows, nil
+	input := &dynamodb.ScanInput{
+		TableName: aws.String(s.tableName),
+		Limit:     aws.Int32(1000),
 	}
 
-	// Convert to []any for filtering
-	var dataInterface []any
-	for _, loanCashFlow := range loanCashFlows {
-		dataInterface = append(dataInterface, loanCashFlow)
+	var conditions []string
+	if rangeCondition := query.dateRangeCondition(expr, "postdate"); rangeCondition != "" {
+		conditions = append(conditions, rangeCondition)
+	}
+	if filter := query.filterExpression(expr, false); filter != "" {
+		conditions = append(conditions, filter)
 	}
+	if len(conditions) > 0 {
+		input.FilterExpression = aws.String("(" + strings.Join(conditions, ") AND (") + ")")
+	}
+	if projection := query.projectionExpression(expr); projection != "" {
+		input.ProjectionExpression = aws.String(projection)
+	}
+	input.ExpressionAttributeNames = expr.attributeNames()
+	input.ExpressionAttributeValues = expr.attributeValues()
 
-	// Apply each field filter
-	for fieldName := range fieldFilters {
-		var getFieldValue func(any) string
+	return input
+}
 
-		switch fieldName {
-		case "loancode":
-			getFieldValue = func(item any) string {
-				if lcf, ok := item.(*model.LoanCashFlow); ok {
-					return lcf.LoanCode
-				}
-				return ""
-			}
-		default:
-			// Skip unknown fields
-			continue
-		}
+// endDateQuery builds a query for a string end date argument in any format the Date scalar accepts
+func endDateQuery(endDate *string) (LoanCashFlowQuery, error) {
+	if endDate == nil || *endDate == "" {
+		return LoanCashFlowQuery{}, nil
+	}
+
+	date, err := model.ParseDate(*endDate)
+	if err != nil {
+		return LoanCashFlowQuery{}, fmt.Errorf("invalid endDate: %w", err)
+	}
+	return NewLoanCashFlowQuery(nil, &date, nil, nil, false, nil)
+}
 
-		dataInterface = acl.FilterArrayByField(dataInterface, fieldName, fieldFilters, getFieldValue)
+// GetByLoanCodesWithEndDateAndFieldFilters retrieves loan cash flows for multiple loan codes with end date and field filtering
+func (s *LoanCashFlowService) GetByLoanCodesWithEndDateAndFieldFilters(ctx context.Context, loanCodes []*string, endDate *string, columnPermissions *acl.ColumnPermissions, fieldFilters map[string]acl.FieldFilter) ([]*model.LoanCashFlow, error) {
+	query, err := endDateQuery(endDate)
+	if err != nil {
+		return nil, err
 	}
 
-	// Convert back to []*model.LoanCashFlow
-	var filteredLoanCashFlows []*model.LoanCashFlow
-	for _, item := range dataInterface {
-		if lcf, ok := item.(*model.LoanCashFlow); ok {
-			filteredLoanCashFlows = append(filteredLoanCashFlows, lcf)
-		}
+	return s.GetByLoanCodesWithQueryAndFieldFilters(ctx, loanCodes, query, columnPermissions, fieldFilters)
+}
+
+// GetByLoanCodesWithQueryAndFieldFilters retrieves loan cash flows for multiple loan codes with query and field filtering.
+// Field filters are conditions like any other, so DynamoDB evaluates them where it can.
+func (s *LoanCashFlowService) GetByLoanCodesWithQueryAndFieldFilters(ctx context.Context, loanCodes []*string, query LoanCashFlowQuery, columnPermissions *acl.ColumnPermissions, fieldFilters map[string]acl.FieldFilter) ([]*model.LoanCashFlow, error) {
+	query, err := query.withFieldFilters(fieldFilters)
+	if err != nil {
+		return nil, err
 	}
 
-	return filteredLoanCashFlows, nil
+	return s.GetByLoanCodesWithQuery(ctx, loanCodes, query, columnPermissions)
 }
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"ssot/gql/graphql/internal/dynamotest"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+func TestScanAllSegmentsCapsTotal(t *testing.T) {
+	const segments, perSegment, maxItems = 4, 3, 5
+
+	server := dynamotest.NewServer(t)
+	server.Handle("Scan", func(input map[string]any) (any, error) {
+		segment := int(input["Segment"].(float64))
+		var items []dynamotest.Item
+		for i := range perSegment {
+			items = append(items, cashFlowItem(fmt.Sprintf("L%d", segment), fmt.Sprint(i)))
+		}
+		input["Limit"] = float64(1)
+		return dynamotest.Page(items, input, "loancode", sortKeyAttribute), nil
+	})
+	service := NewLoanCashFlowService(server.Client(), "loancashflow", LoanCashFlowOptions{
+		MaxItemsPerQuery: maxItems,
+		ScanSegments:     segments,
+	})
+
+	items, err := service.scanAllSegments(context.Background(), LoanCashFlowQuery{})
+	if err != nil {
+		t.Fatalf("scanAllSegments: %v", err)
+	}
+	if len(items) != maxItems {
+		t.Errorf("read %d items across %d segments, want the cap of %d", len(items), segments, maxItems)
+	}
+}
+
+func TestReadWithin(t *testing.T) {
+	// pages serves the given page sizes in order, each item carrying its position
+	pages := func(sizes ...int) *pageIterator {
+		page := 0
+		return &pageIterator{fetch: func(ctx context.Context, startKey map[string]types.AttributeValue) ([]map[string]types.AttributeValue, map[string]types.AttributeValue, error) {
+			items := make([]map[string]types.AttributeValue, sizes[page])
+			for i := range items {
+				items[i] = map[string]types.AttributeValue{"maxHmy": &types.AttributeValueMemberN{Value: fmt.Sprint(page*100 + i)}}
+			}
+			page++
+			if page == len(sizes) {
+				return items, nil, nil
+			}
+			return items, map[string]types.AttributeValue{"page": &types.AttributeValueMemberN{Value: fmt.Sprint(page)}}, nil
+		}}
+	}
+
+	tests := []struct {
+		name       string
+		sizes      []int
+		limit      int
+		budget     int
+		want       int
+		wantCapped bool
+	}{
+		{name: "everything fits", sizes: []int{2, 2}, budget: 10, want: 4},
+		{name: "exactly the cap with no more pages", sizes: []int{2, 3}, budget: 5, want: 5},
+		{name: "cap reached with pages left", sizes: []int{3, 2, 1}, budget: 5, want: 5, wantCapped: true},
+		{name: "cap inside a page", sizes: []int{4, 4}, budget: 6, want: 6, wantCapped: true},
+		{name: "limit before the cap", sizes: []int{4, 4}, limit: 3, budget: 6, want: 3},
+		{name: "no cap", sizes: []int{4, 4}, budget: 0, want: 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			budget := newItemBudget("test", tt.budget)
+			items, err := readWithin(context.Background(), pages(tt.sizes...), nil, tt.limit, budget)
+			if err != nil {
+				t.Fatalf("readWithin: %v", err)
+			}
+			if len(items) != tt.want {
+				t.Errorf("read %d items, want %d", len(items), tt.want)
+			}
+			if budget.capped != tt.wantCapped {
+				t.Errorf("capped = %v, want %v", budget.capped, tt.wantCapped)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// pageIterator walks the pages of a DynamoDB Query or Scan by following LastEvaluatedKey
+type pageIterator struct {
+	fetch    func(ctx context.Context, startKey map[string]types.AttributeValue) ([]map[string]types.AttributeValue, map[string]types.AttributeValue, error)
+	startKey map[string]types.AttributeValue
+	done     bool
+}
+
+// newQueryIterator creates an iterator over every page of a Query, starting at input.ExclusiveStartKey
+func newQueryIterator(client *dynamodb.Client, input *dynamodb.QueryInput) *pageIterator {
+	return &pageIterator{
+		startKey: input.ExclusiveStartKey,
+		fetch: func(ctx context.Context, startKey map[string]types.AttributeValue) ([]map[string]types.AttributeValue, map[string]types.AttributeValue, error) {
+			pageInput := *input
+			pageInput.ExclusiveStartKey = startKey
+
+			result, err := client.Query(ctx, &pageInput)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to query DynamoDB: %w", err)
+			}
+			return result.Items, result.LastEvaluatedKey, nil
+		},
+	}
+}
+
+// newScanIterator creates an iterator over every page of a Scan, starting at input.ExclusiveStartKey
+func newScanIterator(client *dynamodb.Client, input *dynamodb.ScanInput) *pageIterator {
+	return &pageIterator{
+		startKey: input.ExclusiveStartKey,
+		fetch: func(ctx context.Context, startKey map[string]types.AttributeValue) ([]map[string]types.AttributeValue, map[string]types.AttributeValue, error) {
+			pageInput := *input
+			pageInput.ExclusiveStartKey = startKey
+
+			result, err := client.Scan(ctx, &pageInput)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to scan DynamoDB: %w", err)
+			}
+			return result.Items, result.LastEvaluatedKey, nil
+		},
+	}
+}
+
+// HasMorePages reports whether another page can be read
+func (it *pageIterator) HasMorePages() bool {
+	return !it.done
+}
+
+// NextPage reads the next page. It also returns the start key the page was read from,
+// which callers can hand back to DynamoDB to read the same page again.
+func (it *pageIterator) NextPage(ctx context.Context) ([]map[string]types.AttributeValue, map[string]types.AttributeValue, error) {
+	if it.done {
+		return nil, nil, fmt.Errorf("no more pages")
+	}
+
+	pageKey := it.startKey
+	items, lastKey, err := it.fetch(ctx, pageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	it.startKey = lastKey
+	it.done = len(lastKey) == 0
+
+	return items, pageKey, nil
+}
+
+// CappedReadError is returned instead of a partial result when a read that must be complete
+// reaches the item cap
+type CappedReadError struct {
+	Result CappedResult
+}
+
+func (e *CappedReadError) Error() string {
+	return fmt.Sprintf("read of %s stopped at the cap of %d items; narrow the request", e.Result.Source, e.Result.Limit)
+}
+
+type completeReadsKey struct{}
+
+// requireCompleteReads makes the reads under ctx fail rather than return partial results:
+// capped reads return a CappedReadError and a failed loan code fails the whole read
+func requireCompleteReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, completeReadsKey{}, true)
+}
+
+// completeReadsRequired reports whether requireCompleteReads applies to ctx
+func completeReadsRequired(ctx context.Context) bool {
+	required, _ := ctx.Value(completeReadsKey{}).(bool)
+	return required
+}
+
+// itemBudget caps the items kept by one or more reads, which may run concurrently.
+// A limit of 0 or less never caps.
+type itemBudget struct {
+	source    string
+	limit     int
+	mu        sync.Mutex
+	remaining int
+	capped    bool
+}
+
+// newItemBudget creates a budget of limit items; a capped read is reported under source
+func newItemBudget(source string, limit int) *itemBudget {
+	return &itemBudget{source: source, limit: limit, remaining: limit}
+}
+
+// take claims up to n items and returns how many may be kept
+func (b *itemBudget) take(n int) int {
+	if b.limit <= 0 {
+		return n
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	kept := min(n, b.remaining)
+	b.remaining -= kept
+	return kept
+}
+
+// exhausted reports whether no more items may be kept
+func (b *itemBudget) exhausted() bool {
+	if b.limit <= 0 {
+		return false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.remaining == 0
+}
+
+// markCapped records that items were left unread, reporting it in the response once.
+// It fails instead if the read must be complete.
+func (b *itemBudget) markCapped(ctx context.Context) error {
+	b.mu.Lock()
+	first := !b.capped
+	b.capped = true
+	b.mu.Unlock()
+
+	result := CappedResult{Source: b.source, Limit: b.limit}
+	if completeReadsRequired(ctx) {
+		return &CappedReadError{Result: result}
+	}
+	if first {
+		reportCappedResult(ctx, result)
+	}
+	return nil
+}
+
+// readAll collects the items of every page that pass accept (nil accepts all), stopping after
+// limit items (0 for no limit) or once the service's item cap is reached. A capped read is
+// reported in the GraphQL response extensions under the given source, or fails if the
+// read must be complete.
+func (s *LoanCashFlowService) readAll(ctx context.Context, it *pageIterator, source string, accept func(map[string]types.AttributeValue) bool, limit int) ([]map[string]types.AttributeValue, error) {
+	return readWithin(ctx, it, accept, limit, newItemBudget(source, s.maxItemsPerQuery))
+}
+
+// readWithin is readAll with the item cap drawn from a budget that other reads may share
+func readWithin(ctx context.Context, it *pageIterator, accept func(map[string]types.AttributeValue) bool, limit int, budget *itemBudget) ([]map[string]types.AttributeValue, error) {
+	var items []map[string]types.AttributeValue
+
+	for it.HasMorePages() {
+		// Another read may have used up the shared budget while this one still had pages
+		if budget.exhausted() {
+			if err := budget.markCapped(ctx); err != nil {
+				return nil, err
+			}
+			break
+		}
+
+		page, _, err := it.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var accepted []map[string]types.AttributeValue
+		for _, item := range page {
+			if accept == nil || accept(item) {
+				accepted = append(accepted, item)
+			}
+		}
+
+		reachedLimit := limit > 0 && len(items)+len(accepted) >= limit
+		if reachedLimit {
+			accepted = accepted[:limit-len(items)]
+		}
+
+		kept := budget.take(len(accepted))
+		items = append(items, accepted[:kept]...)
+
+		if kept < len(accepted) {
+			if err := budget.markCapped(ctx); err != nil {
+				return nil, err
+			}
+			break
+		}
+		if reachedLimit {
+			break
+		}
+	}
+
+	return items, nil
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// CappedResultsExtension is the GraphQL response extension listing results that were cut short on purpose
+const CappedResultsExtension = "cappedResults"
+
+// CappedResult describes a read that stopped at the service's item cap
+type CappedResult struct {
+	Source string `json:"source"` // What was being read, e.g. "loancode:ABC-123"
+	Limit  int    `json:"limit"`  // Number of items returned before stopping
+}
+
+// cappedResults collects capped reads for one GraphQL response; resolvers may report concurrently
+type cappedResults struct {
+	mutex   sync.Mutex
+	results []CappedResult
+}
+
+// MarshalJSON renders the collected results as a JSON array
+func (c *cappedResults) MarshalJSON() ([]byte, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return json.Marshal(c.results)
+}
+
+// registerMutex guards the lookup-or-register of the per-response collector
+var registerMutex sync.Mutex
+
+// reportCappedResult adds a capped read to the response extensions.
+// It is a no-op outside of a GraphQL operation.
+func reportCappedResult(ctx context.Context, result CappedResult) {
+	if !graphql.HasOperationContext(ctx) {
+		return
+	}
+
+	registerMutex.Lock()
+	collector, ok := graphql.GetExtension(ctx, CappedResultsExtension).(*cappedResults)
+	if !ok {
+		collector = &cappedResults{}
+		graphql.RegisterExtension(ctx, CappedResultsExtension, collector)
+	}
+	registerMutex.Unlock()
+
+	collector.mutex.Lock()
+	collector.results = append(collector.results, result)
+	collector.mutex.Unlock()
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"ssot/gql/graphql/internal/acl"
+)
+
+// IsKnownFieldFilter reports whether a field filter key names a column by its ACL name, either
+// of any dataset (e.g. "loancode", "status") or of the dataset it is scoped to (e.g.
+// "LoanCashFlow.loancode"). Names are matched ignoring case, so legacy keys like "LoanCode"
+// keep working.
+func IsKnownFieldFilter(key string) bool {
+	table, field := acl.ParseFieldFilterKey(key)
+	return hasFieldFilterColumn(LoanCashFlowColumns, table, field) || hasFieldFilterColumn(LoanInfoColumns, table, field)
+}
+
+// hasFieldFilterColumn reports whether a filter on field, scoped to table or global if table is
+// empty, can apply to the registry's dataset
+func hasFieldFilterColumn[T any](registry *ColumnRegistry[T], table, field string) bool {
+	if table != "" && !strings.EqualFold(table, registry.Table()) {
+		return false
+	}
+	_, ok := registry.byACLNameFold(field)
+	return ok
+}
+
+// canonicalFieldFilters rewrites the keys of filters on the registry's columns to the exact
+// table and ACL names, so legacy spellings resolve like current ones. Filters that end up
+// under the same key must all hold.
+func canonicalFieldFilters[T any](registry *ColumnRegistry[T], fieldFilters map[string]acl.FieldFilter) map[string]acl.FieldFilter {
+	canonical := make(map[string]acl.FieldFilter, len(fieldFilters))
+	// Sorted, so filters merged under one key always combine in the same order
+	for _, key := range slices.Sorted(maps.Keys(fieldFilters)) {
+		filter := fieldFilters[key]
+		table, field := acl.ParseFieldFilterKey(key)
+		if hasFieldFilterColumn(registry, table, field) {
+			column, _ := registry.byACLNameFold(field)
+			if table != "" {
+				table = registry.Table()
+			}
+			key = acl.FieldFilterKey(table, column.ACLName)
+		}
+
+		if existing, exists := canonical[key]; exists {
+			filter = acl.FieldFilter{Field: key, AllOf: []acl.FieldFilter{existing, filter}}
+		}
+		canonical[key] = filter
+	}
+	return canonical
+}
+
+// NewFieldFilter turns the caller's field filters into a row condition on the registry's
+// dataset: a row must match a pattern of the include list and none of the exclude list.
+// Filters scoped to the dataset narrow global ones on the same field, and filters on
+// columns of other datasets do not apply. Filters on columns no dataset has are rejected
+// rather than ignored, since skipping them would show rows the filter was meant to hide.
+// Field filters hold whether or not the caller can read the filtered column.
+func NewFieldFilter[T any](registry *ColumnRegistry[T], fieldFilters map[string]acl.FieldFilter) (*RowFilter[T], error) {
+	for key := range fieldFilters {
+		if !IsKnownFieldFilter(key) {
+			return nil, fmt.Errorf("field filter on unknown field %s", key)
+		}
+	}
+
+	resolved := acl.FieldFiltersForTable(canonicalFieldFilters(registry, fieldFilters), registry.Table())
+	names := make([]string, 0, len(resolved))
+	for name := range resolved {
+		names = append(names, name)
+	}
+	// Stable order keeps the generated expressions, and so DynamoDB's query plans, identical
+	slices.Sort(names)
+
+	var conditions []*RowFilter[T]
+	for _, name := range names {
+		column, ok := registry.ByACLName(name)
+		if !ok {
+			continue
+		}
+
+		condition, err := fieldFilterCondition(column, resolved[name])
+		if err != nil {
+			return nil, fmt.Errorf("field filter on %s: %w", name, err)
+		}
+		conditions = append(conditions, condition)
+	}
+
+	return andFilters(conditions...), nil
+}
+
+// fieldFilterCondition builds the condition one field filter places on its column, or nil if
+// it allows every value. Merged filters also require all of AllOf and one of AnyOf.
+func fieldFilterCondition[T any](column Column[T], filter acl.FieldFilter) (*RowFilter[T], error) {
+	var conditions []*RowFilter[T]
+	if len(filter.IncludeList) > 0 {
+		include, err := patternCondition(column, filter.IncludeList)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, include)
+	}
+	if len(filter.ExcludeList) > 0 {
+		exclude, err := patternCondition(column, filter.ExcludeList)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, &RowFilter[T]{not: exclude})
+	}
+
+	for _, child := range filter.AllOf {
+		condition, err := fieldFilterCondition(column, child)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	if len(filter.AnyOf) > 0 {
+		var alternatives []*RowFilter[T]
+		for _, child := range filter.AnyOf {
+			condition, err := fieldFilterCondition(column, child)
+			if err != nil {
+				return nil, err
+			}
+			if condition == nil {
+				// One alternative allows every value, so the others cannot narrow the rows
+				alternatives = nil
+				break
+			}
+			alternatives = append(alternatives, condition)
+		}
+		if alternatives != nil {
+			conditions = append(conditions, &RowFilter[T]{or: alternatives})
+		}
+	}
+
+	return andFilters(conditions...), nil
+}
+
+// patternCondition matches a column against any of a field filter's value patterns. Exact
+// values become a single IN, which keeps loan code lists readable by key; prefixes become
+// begins_with, and globs and regular expressions are matched in memory.
+func patternCondition[T any](column Column[T], patterns []string) (*RowFilter[T], error) {
+	exact := &RowFilter[T]{column: column}
+	var alternatives []*RowFilter[T]
+	for _, value := range patterns {
+		pattern, err := acl.ParseValuePattern(value)
+		if err != nil {
+			return nil, err
+		}
+
+		switch pattern.Kind {
+		case acl.PatternExact:
+			parsed, err := parseFilterValue(column, value)
+			if err != nil {
+				return nil, err
+			}
+			exact.in = append(exact.in, *parsed)
+		case acl.PatternPrefix:
+			alternatives = append(alternatives, &RowFilter[T]{column: column, prefix: &filterValue{text: pattern.Text}})
+		default:
+			alternatives = append(alternatives, &RowFilter[T]{column: column, match: pattern})
+		}
+	}
+
+	if exact.in != nil {
+		alternatives = append([]*RowFilter[T]{exact}, alternatives...)
+	}
+	if len(alternatives) == 1 {
+		return alternatives[0], nil
+	}
+	return &RowFilter[T]{or: alternatives}, nil
+}
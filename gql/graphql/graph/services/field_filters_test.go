@@ -0,0 +1,73 @@
+package services
+
+import (
+	"testing"
+
+	"ssot/gql/graphql/internal/acl"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+func TestFieldFilterLegacyKeys(t *testing.T) {
+	item := func(loanCode, status string) map[string]types.AttributeValue {
+		return map[string]types.AttributeValue{
+			"loancode": &types.AttributeValueMemberS{Value: loanCode},
+			"status":   &types.AttributeValueMemberS{Value: status},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		fieldFilters map[string]acl.FieldFilter
+		allowed      map[string]bool // loan code -> visible, for active loans
+	}{
+		{
+			name:         "legacy global key",
+			fieldFilters: map[string]acl.FieldFilter{"LoanCode": {IncludeList: []string{"L1"}}},
+			allowed:      map[string]bool{"L1": true, "L2": false},
+		},
+		{
+			name:         "legacy scoped key",
+			fieldFilters: map[string]acl.FieldFilter{"loancashflow.LOANCODE": {ExcludeList: []string{"L1"}}},
+			allowed:      map[string]bool{"L1": false, "L2": true},
+		},
+		{
+			name: "legacy and current spellings both hold",
+			fieldFilters: map[string]acl.FieldFilter{
+				"LoanCode": {IncludeList: []string{"L1", "L2"}},
+				"loancode": {ExcludeList: []string{"L2"}},
+			},
+			allowed: map[string]bool{"L1": true, "L2": false},
+		},
+		{
+			name:         "legacy key scoped to another dataset",
+			fieldFilters: map[string]acl.FieldFilter{"LOANINFO.LoanCode": {IncludeList: []string{"L1"}}},
+			allowed:      map[string]bool{"L1": true, "L2": true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key := range tt.fieldFilters {
+				if !IsKnownFieldFilter(key) {
+					t.Errorf("IsKnownFieldFilter(%q) = false", key)
+				}
+			}
+
+			filter, err := NewFieldFilter(LoanCashFlowColumns, tt.fieldFilters)
+			if err != nil {
+				t.Fatalf("NewFieldFilter: %v", err)
+			}
+			for loanCode, want := range tt.allowed {
+				got := filter == nil || filter.matches(item(loanCode, "Active"))
+				if got != want {
+					t.Errorf("loan %s visible = %v, want %v", loanCode, got, want)
+				}
+			}
+		})
+	}
+
+	if _, err := NewFieldFilter(LoanCashFlowColumns, map[string]acl.FieldFilter{"LoanNumber": {IncludeList: []string{"1"}}}); err == nil {
+		t.Error("a filter on an unknown field was accepted")
+	}
+}
@@ -0,0 +1,224 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"ssot/gql/graphql/graph/model"
+	"ssot/gql/graphql/internal/acl"
+)
+
+// averageDigits is how many fractional digits AVG results are rounded to
+const averageDigits = 10
+
+// metricState accumulates one metric of one group
+type metricState struct {
+	sum, min, max, first, last model.Decimal
+	count                      int
+}
+
+// aggregateGroup accumulates the rows of one group
+type aggregateGroup struct {
+	key     []*string
+	count   int
+	metrics []metricState
+}
+
+// AggregateLoanCashFlows groups the rows matched by loanCodes and query by the groupBy fields and
+// computes the metrics of each group. Grouping or aggregating a column the caller cannot read is
+// rejected, and field filters drop rows before they are counted. Groups are ordered by key.
+// Reads that reach the item cap return a CappedReadError rather than partial aggregates.
+func (s *LoanCashFlowService) AggregateLoanCashFlows(ctx context.Context, loanCodes []*string, query LoanCashFlowQuery, groupBy []string, metrics []*model.LoanCashFlowMetricInput, columnPermissions *acl.ColumnPermissions, fieldFilters map[string]acl.FieldFilter) ([]*model.LoanCashFlowAggregateGroup, error) {
+	groupColumns := make([]Column[model.LoanCashFlow], len(groupBy))
+	for i, field := range groupBy {
+		column, err := LoanCashFlowColumns.Readable(field, columnPermissions)
+		if err != nil {
+			return nil, fmt.Errorf("groupBy: %w", err)
+		}
+		groupColumns[i] = column
+	}
+
+	metricColumns := make([]Column[model.LoanCashFlow], len(metrics))
+	ordered := false
+	for i, metric := range metrics {
+		column, err := LoanCashFlowColumns.Readable(metric.Field, columnPermissions)
+		if err != nil {
+			return nil, fmt.Errorf("metrics: %w", err)
+		}
+		if column.Type != ColumnTypeNumber {
+			return nil, fmt.Errorf("metrics: field %s is not numeric", metric.Field)
+		}
+		if !metric.Function.IsValid() {
+			return nil, fmt.Errorf("metrics: unknown function %s", metric.Function)
+		}
+		metricColumns[i] = column
+		ordered = ordered || metric.Function == model.AggregateFunctionFirst || metric.Function == model.AggregateFunctionLast
+	}
+
+	fields := slices.Clone(groupBy)
+	for _, metric := range metrics {
+		fields = append(fields, metric.Field)
+	}
+
+	// FIRST and LAST order rows by post date, so the caller must be able to read it
+	if ordered {
+		if !columnPermissions.IsAllowed("postdate") {
+			return nil, fmt.Errorf("metrics: FIRST and LAST need access to column postdate")
+		}
+		fields = append(fields, "postDate", "maxHmy")
+	}
+
+	query.Columns = LoanCashFlowColumns.Projection(fields, columnPermissions)
+	query.Descending = false
+	query.Latest = 0
+
+	// Aggregates over a capped or partial read would look complete, so such reads fail instead
+	rows, err := s.GetByLoanCodesWithQueryAndFieldFilters(requireCompleteReads(ctx), loanCodes, query, columnPermissions, fieldFilters)
+	if err != nil {
+		return nil, err
+	}
+	if ordered {
+		slices.SortStableFunc(rows, func(a, b *model.LoanCashFlow) int {
+			return cmp.Or(
+				postDateTime(a).Compare(postDateTime(b)),
+				strings.Compare(derefString(a.MaxHmy), derefString(b.MaxHmy)),
+			)
+		})
+	}
+
+	groups := make(map[string]*aggregateGroup)
+	for _, row := range rows {
+		key := make([]*string, len(groupColumns))
+		var id strings.Builder
+		for i, column := range groupColumns {
+			key[i] = column.Text(row)
+			if key[i] != nil {
+				id.WriteString("=" + *key[i])
+			}
+			id.WriteByte(0)
+		}
+
+		group, exists := groups[id.String()]
+		if !exists {
+			group = &aggregateGroup{key: key, metrics: make([]metricState, len(metrics))}
+			groups[id.String()] = group
+		}
+		group.count++
+
+		for i, column := range metricColumns {
+			if value := column.Number(row); value != nil {
+				group.metrics[i].add(*value)
+			}
+		}
+	}
+
+	ordering := make([]*aggregateGroup, 0, len(groups))
+	for _, group := range groups {
+		ordering = append(ordering, group)
+	}
+	slices.SortFunc(ordering, func(a, b *aggregateGroup) int {
+		for i := range a.key {
+			if c := compareKey(a.key[i], b.key[i]); c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+
+	result := make([]*model.LoanCashFlowAggregateGroup, 0, len(ordering))
+	for _, group := range ordering {
+		aggregate := &model.LoanCashFlowAggregateGroup{
+			Key:     make([]*model.LoanCashFlowGroupKey, len(groupBy)),
+			Count:   int32(group.count),
+			Metrics: make([]*model.LoanCashFlowMetricValue, len(metrics)),
+		}
+		for i, field := range groupBy {
+			aggregate.Key[i] = &model.LoanCashFlowGroupKey{Field: field, Value: group.key[i]}
+		}
+		for i, metric := range metrics {
+			aggregate.Metrics[i] = &model.LoanCashFlowMetricValue{
+				Field:    metric.Field,
+				Function: metric.Function,
+				Value:    group.metrics[i].value(metric.Function),
+			}
+		}
+		result = append(result, aggregate)
+	}
+
+	return result, nil
+}
+
+// add folds a value into the metric; values must arrive in post date order for FIRST and LAST
+func (m *metricState) add(value model.Decimal) {
+	if m.count == 0 {
+		m.min, m.max, m.first = value, value, value
+	}
+	m.sum = m.sum.Add(value)
+	if value.Cmp(m.min) < 0 {
+		m.min = value
+	}
+	if value.Cmp(m.max) > 0 {
+		m.max = value
+	}
+	m.last = value
+	m.count++
+}
+
+// value returns the metric's result, or nil if the group had no values for the field.
+// Sums are exact; averages are rounded to averageDigits fractional digits.
+func (m *metricState) value(function model.AggregateFunction) *model.Decimal {
+	if m.count == 0 {
+		return nil
+	}
+
+	var result model.Decimal
+	switch function {
+	case model.AggregateFunctionSum:
+		result = m.sum
+	case model.AggregateFunctionMin:
+		result = m.min
+	case model.AggregateFunctionMax:
+		result = m.max
+	case model.AggregateFunctionAvg:
+		result = m.sum.DivInt(int64(m.count), averageDigits)
+	case model.AggregateFunctionFirst:
+		result = m.first
+	case model.AggregateFunctionLast:
+		result = m.last
+	}
+	return &result
+}
+
+// compareKey orders group key values, with missing values first
+func compareKey(a, b *string) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	default:
+		return strings.Compare(*a, *b)
+	}
+}
+
+// postDateTime returns the row's post date, or the zero time if it is unset
+func postDateTime(row *model.LoanCashFlow) time.Time {
+	if row.PostDate == nil {
+		return time.Time{}
+	}
+	return row.PostDate.Time()
+}
+
+// derefString returns the string a pointer refers to, or "" for nil
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
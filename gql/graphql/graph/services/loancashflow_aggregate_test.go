@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"ssot/gql/graphql/graph/model"
+	"ssot/gql/graphql/internal/dynamotest"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestAggregateLoanCashFlowsRejectsCappedRead(t *testing.T) {
+	items := []dynamotest.Item{cashFlowItem("L1", "1"), cashFlowItem("L1", "2"), cashFlowItem("L1", "3")}
+	for _, item := range items {
+		item["balance"] = dynamotest.N("10")
+	}
+
+	server := dynamotest.NewServer(t)
+	server.Handle("Query", func(input map[string]any) (any, error) {
+		return dynamotest.Page(items, input, "loancode", sortKeyAttribute), nil
+	})
+
+	metrics := []*model.LoanCashFlowMetricInput{{Field: "balance", Function: model.AggregateFunctionSum}}
+	loanCodes := []*string{aws.String("L1")}
+
+	tests := []struct {
+		name       string
+		options    LoanCashFlowOptions
+		wantSum    string
+		wantCapped bool
+	}{
+		{name: "complete read", options: LoanCashFlowOptions{MaxItemsPerQuery: 3}, wantSum: "30"},
+		{name: "capped read", options: LoanCashFlowOptions{MaxItemsPerQuery: 2}, wantCapped: true},
+		{name: "capped read with partial results", options: LoanCashFlowOptions{MaxItemsPerQuery: 2, PartialResults: true}, wantCapped: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewLoanCashFlowService(server.Client(), "loancashflow", tt.options)
+			groups, err := service.AggregateLoanCashFlows(context.Background(), loanCodes, LoanCashFlowQuery{}, nil, metrics, allowAll(LoanCashFlowColumns), nil)
+
+			var capped *CappedReadError
+			if tt.wantCapped {
+				if !errors.As(err, &capped) {
+					t.Fatalf("AggregateLoanCashFlows() error = %v, want a CappedReadError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AggregateLoanCashFlows: %v", err)
+			}
+			if len(groups) != 1 || groups[0].Metrics[0].Value == nil || groups[0].Metrics[0].Value.String() != tt.wantSum {
+				t.Errorf("aggregate = %+v, want one group summing to %s", groups, tt.wantSum)
+			}
+		})
+	}
+}
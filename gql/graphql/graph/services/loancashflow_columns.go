@@ -0,0 +1,32 @@
+package services
+
+import "ssot/gql/graphql/graph/model"
+
+// LoanCashFlowColumns describes every column of the pbi-loancashflow table.
+// Adding a column to the dataset means adding its line here and its field to the schema.
+var LoanCashFlowColumns = NewColumnRegistry("LoanCashFlow",
+	Column[model.LoanCashFlow]{Attribute: "loancode", Field: "loanCode", Type: ColumnTypeString, Target: func(r *model.LoanCashFlow) any { return &r.LoanCode }},
+	Column[model.LoanCashFlow]{Attribute: "maxHmy", Field: "maxHmy", Type: ColumnTypeString, Target: func(r *model.LoanCashFlow) any { return &r.MaxHmy }},
+	Column[model.LoanCashFlow]{Attribute: "accrualenddate", Field: "accrualEndDate", Type: ColumnTypeDate, Target: func(r *model.LoanCashFlow) any { return &r.AccrualEndDate }},
+	Column[model.LoanCashFlow]{Attribute: "accrualstartdate", Field: "accrualStartDate", Type: ColumnTypeDate, Target: func(r *model.LoanCashFlow) any { return &r.AccrualStartDate }},
+	Column[model.LoanCashFlow]{Attribute: "balance", Field: "balance", Type: ColumnTypeNumber, Target: func(r *model.LoanCashFlow) any { return &r.Balance }},
+	Column[model.LoanCashFlow]{Attribute: "capitalizedFee", Field: "capitalizedFee", Type: ColumnTypeNumber, Target: func(r *model.LoanCashFlow) any { return &r.CapitalizedFee }},
+	Column[model.LoanCashFlow]{Attribute: "capitalizedInterest", Field: "capitalizedInterest", Type: ColumnTypeNumber, Target: func(r *model.LoanCashFlow) any { return &r.CapitalizedInterest }},
+	Column[model.LoanCashFlow]{Attribute: "capitalizedLoanAdministrationFee", Field: "capitalizedLoanAdministrationFee", Type: ColumnTypeNumber, Target: func(r *model.LoanCashFlow) any { return &r.CapitalizedLoanAdministrationFee }},
+	Column[model.LoanCashFlow]{Attribute: "capitalizedOtherFees", Field: "capitalizedOtherFees", Type: ColumnTypeNumber, Target: func(r *model.LoanCashFlow) any { return &r.CapitalizedOtherFees }},
+	Column[model.LoanCashFlow]{Attribute: "commitment", Field: "commitment", Type: ColumnTypeNumber, Target: func(r *model.LoanCashFlow) any { return &r.Commitment }},
+	Column[model.LoanCashFlow]{Attribute: "drawActualPrincipal", Field: "drawActualPrincipal", Type: ColumnTypeNumber, Target: func(r *model.LoanCashFlow) any { return &r.DrawActualPrincipal }},
+	Column[model.LoanCashFlow]{Attribute: "ebalance", Field: "eBalance", Type: ColumnTypeNumber, Target: func(r *model.LoanCashFlow) any { return &r.EBalance }},
+	Column[model.LoanCashFlow]{Attribute: "glPerioddate", Field: "glPeriodDate", Type: ColumnTypeDate, Target: func(r *model.LoanCashFlow) any { return &r.GlPeriodDate }},
+	Column[model.LoanCashFlow]{Attribute: "interest", Field: "interest", Type: ColumnTypeNumber, Target: func(r *model.LoanCashFlow) any { return &r.Interest }},
+	Column[model.LoanCashFlow]{Attribute: "leverageActivity", Field: "leverageActivity", Type: ColumnTypeNumber, Target: func(r *model.LoanCashFlow) any { return &r.LeverageActivity }},
+	Column[model.LoanCashFlow]{Attribute: "leverageBalance", Field: "leverageBalance", Type: ColumnTypeNumber, Target: func(r *model.LoanCashFlow) any { return &r.LeverageBalance }},
+	Column[model.LoanCashFlow]{Attribute: "leverageInterest", Field: "leverageInterest", Type: ColumnTypeNumber, Target: func(r *model.LoanCashFlow) any { return &r.LeverageInterest }},
+	Column[model.LoanCashFlow]{Attribute: "loandesc", Field: "loanDesc", Type: ColumnTypeString, Target: func(r *model.LoanCashFlow) any { return &r.LoanDesc }},
+	Column[model.LoanCashFlow]{Attribute: "paymentnumber", Field: "paymentNumber", Type: ColumnTypeString, Target: func(r *model.LoanCashFlow) any { return &r.PaymentNumber }},
+	Column[model.LoanCashFlow]{Attribute: "postdate", Field: "postDate", Type: ColumnTypeDateTime, Target: func(r *model.LoanCashFlow) any { return &r.PostDate }},
+	Column[model.LoanCashFlow]{Attribute: "propertycode", Field: "propertyCode", Type: ColumnTypeString, Target: func(r *model.LoanCashFlow) any { return &r.PropertyCode }},
+	Column[model.LoanCashFlow]{Attribute: "propertyname", Field: "propertyName", Type: ColumnTypeString, Target: func(r *model.LoanCashFlow) any { return &r.PropertyName }},
+	Column[model.LoanCashFlow]{Attribute: "sbalance", Field: "sBalance", Type: ColumnTypeNumber, Target: func(r *model.LoanCashFlow) any { return &r.SBalance }},
+	Column[model.LoanCashFlow]{Attribute: "status", Field: "status", Type: ColumnTypeString, Target: func(r *model.LoanCashFlow) any { return &r.Status }},
+)
@@ -0,0 +1,229 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"ssot/gql/graphql/graph/model"
+	"ssot/gql/graphql/internal/acl"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+const (
+	defaultConnectionPageSize = 100
+	maxConnectionPageSize     = 1000
+)
+
+// loanCashFlowCursor marks a position in a byLoanCode result set.
+// StartKey is the ExclusiveStartKey of the DynamoDB page holding the position and
+// Skip is the number of items of that page that come before it.
+type loanCashFlowCursor struct {
+	LoanIndex int                        `json:"l"`
+	StartKey  map[string]cursorAttribute `json:"k,omitempty"`
+	Skip      int                        `json:"s"`
+}
+
+// cursorAttribute is the JSON form of a key attribute inside a cursor
+type cursorAttribute struct {
+	S *string `json:"S,omitempty"`
+	N *string `json:"N,omitempty"`
+}
+
+// encodeLoanCashFlowCursor wraps a DynamoDB start key and offset into an opaque cursor
+func encodeLoanCashFlowCursor(loanIndex int, startKey map[string]types.AttributeValue, skip int) (string, error) {
+	cursor := loanCashFlowCursor{
+		LoanIndex: loanIndex,
+		Skip:      skip,
+	}
+
+	if len(startKey) > 0 {
+		cursor.StartKey = make(map[string]cursorAttribute, len(startKey))
+		for name, value := range startKey {
+			switch v := value.(type) {
+			case *types.AttributeValueMemberS:
+				cursor.StartKey[name] = cursorAttribute{S: aws.String(v.Value)}
+			case *types.AttributeValueMemberN:
+				cursor.StartKey[name] = cursorAttribute{N: aws.String(v.Value)}
+			default:
+				return "", fmt.Errorf("unsupported key attribute type %T for %s", value, name)
+			}
+		}
+	}
+
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeLoanCashFlowCursor unwraps a cursor produced by encodeLoanCashFlowCursor
+func decodeLoanCashFlowCursor(encoded string) (int, map[string]types.AttributeValue, int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return 0, nil, 0, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	var cursor loanCashFlowCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return 0, nil, 0, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	if cursor.LoanIndex < 0 || cursor.Skip < 0 {
+		return 0, nil, 0, fmt.Errorf("invalid cursor: negative position")
+	}
+
+	var startKey map[string]types.AttributeValue
+	if len(cursor.StartKey) > 0 {
+		startKey = make(map[string]types.AttributeValue, len(cursor.StartKey))
+		for name, value := range cursor.StartKey {
+			switch {
+			case value.S != nil:
+				startKey[name] = &types.AttributeValueMemberS{Value: *value.S}
+			case value.N != nil:
+				startKey[name] = &types.AttributeValueMemberN{Value: *value.N}
+			default:
+				return 0, nil, 0, fmt.Errorf("invalid cursor: empty key attribute %s", name)
+			}
+		}
+	}
+
+	return cursor.LoanIndex, startKey, cursor.Skip, nil
+}
+
+// connectionPageSize validates the requested page size and applies the default
+func connectionPageSize(first *int32) (int, error) {
+	if first == nil {
+		return defaultConnectionPageSize, nil
+	}
+	if *first < 0 {
+		return 0, fmt.Errorf("first must not be negative")
+	}
+	if *first > maxConnectionPageSize {
+		return 0, fmt.Errorf("first must not exceed %d", maxConnectionPageSize)
+	}
+	return int(*first), nil
+}
+
+// GetConnectionByLoanCodes returns one page of loan cash flows for the given loan codes,
+// or for all loans if the array is empty. Cursors wrap DynamoDB's LastEvaluatedKey so a
+// page only reads the items it returns, plus one to detect whether more exist.
+// The query's order applies within each loan code; a full scan is paged in table order.
+func (s *LoanCashFlowService) GetConnectionByLoanCodes(ctx context.Context, loanCodes []*string, query LoanCashFlowQuery, first *int32, after *string, columnPermissions *acl.ColumnPermissions, fieldFilters map[string]acl.FieldFilter) (*model.LoanCashFlowConnection, error) {
+	pageSize, err := connectionPageSize(first)
+	if err != nil {
+		return nil, err
+	}
+	query, err = query.withFieldFilters(fieldFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	var startIndex, startSkip int
+	var startKey map[string]types.AttributeValue
+	if after != nil && *after != "" {
+		startIndex, startKey, startSkip, err = decodeLoanCashFlowCursor(*after)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var codes []string
+	for _, loanCodePtr := range loanCodes {
+		if loanCodePtr != nil {
+			codes = append(codes, *loanCodePtr)
+		}
+	}
+
+	// An empty loan code list pages through a single table scan, unless a loanCode filter names the loans
+	if len(loanCodes) == 0 {
+		codes = query.Filter.keyValues("loancode")
+	}
+	sources := len(codes)
+	if len(loanCodes) == 0 && len(codes) == 0 {
+		sources = 1
+	}
+	if startIndex >= sources && (startIndex > 0 || len(startKey) > 0) {
+		return nil, fmt.Errorf("invalid cursor: position is outside the requested loan codes")
+	}
+
+	connection := &model.LoanCashFlowConnection{
+		Edges: []*model.LoanCashFlowEdge{},
+		PageInfo: &model.PageInfo{
+			HasPreviousPage: after != nil && *after != "",
+		},
+	}
+
+	for loanIndex := startIndex; loanIndex < sources && !connection.PageInfo.HasNextPage; loanIndex++ {
+		var resumeKey map[string]types.AttributeValue
+		skip := 0
+		if loanIndex == startIndex {
+			resumeKey, skip = startKey, startSkip
+		}
+
+		it := s.connectionIterator(codes, loanIndex, query, resumeKey, pageSize+1)
+		accepts := query.accepts(len(codes) > 0)
+
+		for it.HasMorePages() && !connection.PageInfo.HasNextPage {
+			items, pageKey, err := it.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			for i := skip; i < len(items); i++ {
+				if !accepts(items[i]) {
+					continue
+				}
+
+				loanCashFlow, err := LoanCashFlowColumns.Decode(items[i], columnPermissions)
+				if err != nil {
+					return nil, fmt.Errorf("failed to convert DynamoDB item: %w", err)
+				}
+
+				if len(connection.Edges) == pageSize {
+					connection.PageInfo.HasNextPage = true
+					break
+				}
+
+				cursor, err := encodeLoanCashFlowCursor(loanIndex, pageKey, i+1)
+				if err != nil {
+					return nil, err
+				}
+				connection.Edges = append(connection.Edges, &model.LoanCashFlowEdge{
+					Cursor: cursor,
+					Node:   loanCashFlow,
+				})
+			}
+
+			skip = max(0, skip-len(items))
+		}
+	}
+
+	if len(connection.Edges) > 0 {
+		connection.PageInfo.StartCursor = &connection.Edges[0].Cursor
+		connection.PageInfo.EndCursor = &connection.Edges[len(connection.Edges)-1].Cursor
+	}
+
+	return connection, nil
+}
+
+// connectionIterator pages through the loan code at loanIndex, or through the table scan
+// if no loan codes were requested, starting at startKey
+func (s *LoanCashFlowService) connectionIterator(codes []string, loanIndex int, query LoanCashFlowQuery, startKey map[string]types.AttributeValue, limit int) *pageIterator {
+	if len(codes) == 0 {
+		input := s.scanInput(query)
+		input.Limit = aws.Int32(int32(limit))
+		input.ExclusiveStartKey = startKey
+		return newScanIterator(s.client, input)
+	}
+
+	input := s.loanCodeQueryInput(codes[loanIndex], query)
+	input.Limit = aws.Int32(int32(limit))
+	input.ExclusiveStartKey = startKey
+	return newQueryIterator(s.client, input)
+}
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"ssot/gql/graphql/internal/acl"
+	"ssot/gql/graphql/internal/dynamotest"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+func TestLoanCashFlowCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		loanIndex int
+		startKey  map[string]types.AttributeValue
+		skip      int
+	}{
+		{
+			name:      "first page of a loan",
+			loanIndex: 0,
+			skip:      3,
+		},
+		{
+			name:      "string and number key attributes",
+			loanIndex: 2,
+			startKey: map[string]types.AttributeValue{
+				"loancode":        &types.AttributeValueMemberS{Value: "L1"},
+				"postdate#maxHmy": &types.AttributeValueMemberS{Value: "2024-01-31#12"},
+				"segment":         &types.AttributeValueMemberN{Value: "7"},
+			},
+			skip: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := encodeLoanCashFlowCursor(tt.loanIndex, tt.startKey, tt.skip)
+			if err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+
+			loanIndex, startKey, skip, err := decodeLoanCashFlowCursor(encoded)
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if loanIndex != tt.loanIndex || skip != tt.skip {
+				t.Errorf("position = (%d, %d), want (%d, %d)", loanIndex, skip, tt.loanIndex, tt.skip)
+			}
+			if len(startKey) != len(tt.startKey) {
+				t.Fatalf("start key has %d attributes, want %d", len(startKey), len(tt.startKey))
+			}
+			for name, want := range tt.startKey {
+				if got := startKey[name]; !sameAttribute(got, want) {
+					t.Errorf("start key %s = %#v, want %#v", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestLoanCashFlowCursorRejectsInvalid(t *testing.T) {
+	negative, err := encodeLoanCashFlowCursor(0, nil, -1)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+
+	cursors := map[string]string{
+		"not base64":          "%%%",
+		"not json":            "bm90IGpzb24",
+		"negative position":   negative,
+		"empty key attribute": "eyJsIjowLCJrIjp7ImxvYW5jb2RlIjp7fX0sInMiOjB9",
+	}
+
+	for name, cursor := range cursors {
+		t.Run(name, func(t *testing.T) {
+			if _, _, _, err := decodeLoanCashFlowCursor(cursor); err == nil {
+				t.Errorf("decodeLoanCashFlowCursor(%q) succeeded, want error", cursor)
+			}
+		})
+	}
+
+	if _, err := encodeLoanCashFlowCursor(0, map[string]types.AttributeValue{"flag": &types.AttributeValueMemberBOOL{Value: true}}, 0); err == nil {
+		t.Error("encoding a boolean key attribute succeeded, want error")
+	}
+}
+
+func TestConnectionPageSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		first   *int32
+		want    int
+		wantErr bool
+	}{
+		{name: "default", first: nil, want: defaultConnectionPageSize},
+		{name: "zero", first: aws.Int32(0), want: 0},
+		{name: "maximum", first: aws.Int32(maxConnectionPageSize), want: maxConnectionPageSize},
+		{name: "above maximum", first: aws.Int32(maxConnectionPageSize + 1), wantErr: true},
+		{name: "negative", first: aws.Int32(-1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := connectionPageSize(tt.first)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("connectionPageSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("connectionPageSize() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetConnectionByLoanCodesPages(t *testing.T) {
+	partitions := map[string][]dynamotest.Item{
+		"L1": {cashFlowItem("L1", "1"), cashFlowItem("L1", "2"), cashFlowItem("L1", "3")},
+		"L2": {cashFlowItem("L2", "4"), cashFlowItem("L2", "5")},
+	}
+
+	server := dynamotest.NewServer(t)
+	server.Handle("Query", func(input map[string]any) (any, error) {
+		for _, value := range dynamotest.StringValues(input) {
+			if items, ok := partitions[value]; ok {
+				return dynamotest.Page(items, input, "loancode", sortKeyAttribute), nil
+			}
+		}
+		return dynamotest.Page(nil, input), nil
+	})
+	service := NewLoanCashFlowService(server.Client(), "loancashflow", LoanCashFlowOptions{})
+
+	loanCodes := []*string{aws.String("L1"), aws.String("L2")}
+	var after *string
+	var pages [][]string
+
+	for page := 0; page < 5; page++ {
+		connection, err := service.GetConnectionByLoanCodes(context.Background(), loanCodes, LoanCashFlowQuery{}, aws.Int32(2), after, allowAll(LoanCashFlowColumns), nil)
+		if err != nil {
+			t.Fatalf("page %d: %v", page, err)
+		}
+		if connection.PageInfo.HasPreviousPage != (after != nil) {
+			t.Errorf("page %d: hasPreviousPage = %v", page, connection.PageInfo.HasPreviousPage)
+		}
+
+		var hmys []string
+		for _, edge := range connection.Edges {
+			hmys = append(hmys, *edge.Node.MaxHmy)
+		}
+		pages = append(pages, hmys)
+
+		if !connection.PageInfo.HasNextPage {
+			break
+		}
+		after = connection.PageInfo.EndCursor
+	}
+
+	want := [][]string{{"1", "2"}, {"3", "4"}, {"5"}}
+	if len(pages) != len(want) {
+		t.Fatalf("read pages %v, want %v", pages, want)
+	}
+	for i := range want {
+		if len(pages[i]) != len(want[i]) {
+			t.Fatalf("read pages %v, want %v", pages, want)
+		}
+		for j := range want[i] {
+			if pages[i][j] != want[i][j] {
+				t.Fatalf("read pages %v, want %v", pages, want)
+			}
+		}
+	}
+}
+
+func TestGetConnectionByLoanCodesRejectsCursorOutsideLoanCodes(t *testing.T) {
+	server := dynamotest.NewServer(t)
+	service := NewLoanCashFlowService(server.Client(), "loancashflow", LoanCashFlowOptions{})
+
+	cursor, err := encodeLoanCashFlowCursor(3, nil, 1)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+
+	_, err = service.GetConnectionByLoanCodes(context.Background(), []*string{aws.String("L1")}, LoanCashFlowQuery{}, nil, &cursor, allowAll(LoanCashFlowColumns), nil)
+	if err == nil {
+		t.Fatal("a cursor past the requested loan codes was accepted")
+	}
+}
+
+// allowAll grants every column of the registry
+func allowAll[T any](registry *ColumnRegistry[T]) *acl.ColumnPermissions {
+	access := make(map[string]string)
+	for _, column := range registry.ACLColumns() {
+		access[column] = "allowed"
+	}
+	return acl.NewColumnPermissions(registry.Table(), access, false)
+}
+
+func cashFlowItem(loanCode, hmy string) dynamotest.Item {
+	return dynamotest.Item{
+		"loancode":       dynamotest.S(loanCode),
+		"maxHmy":         dynamotest.S(hmy),
+		sortKeyAttribute: dynamotest.S("2024-01-01#" + hmy),
+	}
+}
+
+func sameAttribute(a, b types.AttributeValue) bool {
+	switch a := a.(type) {
+	case *types.AttributeValueMemberS:
+		b, ok := b.(*types.AttributeValueMemberS)
+		return ok && a.Value == b.Value
+	case *types.AttributeValueMemberN:
+		b, ok := b.(*types.AttributeValueMemberN)
+		return ok && a.Value == b.Value
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"ssot/gql/graphql/graph/model"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// defaultConcurrency is how many loan codes are queried at once when no limit is configured
+const defaultConcurrency = 8
+
+// LoanCodeError records a loan code whose cash flows could not be read
+type LoanCodeError struct {
+	LoanCode string
+	Err      error
+}
+
+func (e *LoanCodeError) Error() string {
+	return fmt.Sprintf("failed to get loan cash flows for loan code %s: %v", e.LoanCode, e.Err)
+}
+
+func (e *LoanCodeError) Unwrap() error {
+	return e.Err
+}
+
+// getByLoanCodesConcurrently runs fetch for every loan code on a bounded pool of workers.
+// Rows are returned in the order of loanCodes regardless of which query finishes first.
+// By default the first failure cancels the queries still running and is returned; with
+// partial results enabled, failed loan codes are reported as GraphQL errors instead, unless
+// the read must be complete.
+func (s *LoanCashFlowService) getByLoanCodesConcurrently(ctx context.Context, loanCodes []string, fetch func(ctx context.Context, loanCode string) ([]*model.LoanCashFlow, error)) ([]*model.LoanCashFlow, error) {
+	partialResults := s.partialResults && !completeReadsRequired(ctx)
+
+	results, failures, err := fanOut(ctx, s.concurrency, loanCodes, !partialResults, func(ctx context.Context, loanCode string) ([]*model.LoanCashFlow, error) {
+		loanCashFlows, err := fetch(ctx, loanCode)
+		if err != nil {
+			return nil, &LoanCodeError{LoanCode: loanCode, Err: err}
+		}
+		return loanCashFlows, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var allLoanCashFlows []*model.LoanCashFlow
+	var failed []*LoanCodeError
+	for i := range loanCodes {
+		if failures[i] != nil {
+			failed = append(failed, failures[i].(*LoanCodeError))
+			continue
+		}
+		allLoanCashFlows = append(allLoanCashFlows, results[i]...)
+	}
+
+	if len(failed) > 0 {
+		if len(failed) == len(loanCodes) {
+			return nil, failed[0]
+		}
+		for _, failure := range failed {
+			reportLoanCodeError(ctx, failure)
+		}
+	}
+
+	return allLoanCashFlows, nil
+}
+
+// fanOut runs fetch for every key on a pool of at most concurrency workers and returns the
+// results and failures in the order of keys. With failFast, the first failure cancels the
+// fetches still running and is returned as the error; otherwise every key is fetched.
+func fanOut[T any](ctx context.Context, concurrency int, keys []string, failFast bool, fetch func(ctx context.Context, key string) (T, error)) ([]T, []error, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]T, len(keys))
+	failures := make([]error, len(keys))
+
+	var firstFailure error
+	var failOnce sync.Once
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(concurrency, len(keys)) {
+		wg.Go(func() {
+			for i := range indexes {
+				result, err := fetch(ctx, keys[i])
+				if err != nil {
+					failures[i] = err
+					if failFast {
+						failOnce.Do(func() {
+							firstFailure = err
+							cancel()
+						})
+					}
+					continue
+				}
+				results[i] = result
+			}
+		})
+	}
+
+feed:
+	for i := range keys {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if firstFailure != nil {
+		return nil, nil, firstFailure
+	}
+	// Nothing failed but the caller gave up, so whatever was read is incomplete
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return results, failures, nil
+}
+
+// reportLoanCodeError adds a failed loan code to the GraphQL response errors, next to the
+// rows of the loan codes that succeeded. Outside of a resolver the failure is dropped.
+func reportLoanCodeError(ctx context.Context, failure *LoanCodeError) {
+	if graphql.GetFieldContext(ctx) == nil {
+		return
+	}
+
+	graphql.AddError(ctx, &gqlerror.Error{
+		Message: failure.Error(),
+		Path:    graphql.GetPath(ctx),
+		Extensions: map[string]any{
+			"code":     "LOAN_CODE_FAILED",
+			"loanCode": failure.LoanCode,
+		},
+	})
+}
@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"ssot/gql/graphql/internal/dynamotest"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestGetByLoanCodesKeepsRequestedOrder(t *testing.T) {
+	partitions := map[string][]dynamotest.Item{
+		"L1": {cashFlowItem("L1", "1"), cashFlowItem("L1", "2")},
+		"L2": {cashFlowItem("L2", "3")},
+		"L3": {cashFlowItem("L3", "4"), cashFlowItem("L3", "5")},
+	}
+
+	server := dynamotest.NewServer(t)
+	server.Handle("Query", func(input map[string]any) (any, error) {
+		for _, value := range dynamotest.StringValues(input) {
+			if items, ok := partitions[value]; ok {
+				return dynamotest.Page(items, input, "loancode", sortKeyAttribute), nil
+			}
+		}
+		return dynamotest.Page(nil, input), nil
+	})
+	service := NewLoanCashFlowService(server.Client(), "loancashflow", LoanCashFlowOptions{Concurrency: 3})
+
+	loanCodes := []*string{aws.String("L3"), nil, aws.String("L1"), aws.String("L2")}
+	rows, err := service.GetByLoanCodes(context.Background(), loanCodes, allowAll(LoanCashFlowColumns))
+	if err != nil {
+		t.Fatalf("GetByLoanCodes: %v", err)
+	}
+
+	want := []string{"4", "5", "1", "2", "3"}
+	if len(rows) != len(want) {
+		t.Fatalf("got %d rows, want %d", len(rows), len(want))
+	}
+	for i, row := range rows {
+		if *row.MaxHmy != want[i] {
+			t.Errorf("row %d maxHmy = %s, want %s", i, *row.MaxHmy, want[i])
+		}
+	}
+
+	if queries := len(server.Requests("Query")); queries != 3 {
+		t.Errorf("ran %d queries, want one per loan code", queries)
+	}
+}
@@ -0,0 +1,309 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"ssot/gql/graphql/graph/model"
+	"ssot/gql/graphql/internal/acl"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+const (
+	// loanCodeIndexName is the GSI keyed by loancode with the postdate#maxHmy sort key
+	loanCodeIndexName = "loancode-postdate-maxHmy-index"
+	// propertyCodeIndexName is the pbi-loaninfo GSI keyed by propertycode. It must project
+	// loancode and propertyname.
+	propertyCodeIndexName = "propertycode-index"
+	// sortKeyAttribute is the composite "2006-01-02T15:04:05#<maxHmy>" sort key
+	sortKeyAttribute = "postdate#maxHmy"
+	// endOfDaySuffix sorts after every "T15:04:05#<maxHmy>" suffix, making a day's upper bound inclusive
+	endOfDaySuffix = "~"
+)
+
+// loanCodeIndexKeys are the key attributes of loanCodeIndexName, which a Query's filter expression may not use
+var loanCodeIndexKeys = []string{"loancode", sortKeyAttribute}
+
+// storedDateLayouts are the formats date columns are found in, most common first
+var storedDateLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+	"1/2/2006 3:04:05 PM",
+	"1/2/2006",
+}
+
+// LoanCashFlowQuery narrows which loan cash flow rows are read
+type LoanCashFlowQuery struct {
+	StartDate     *time.Time                      // Inclusive lower bound on postdate, applied to the sort key
+	EndDate       *time.Time                      // Inclusive upper bound on postdate, applied to the sort key
+	GLPeriodStart *time.Time                      // Inclusive lower bound on glPerioddate
+	GLPeriodEnd   *time.Time                      // Inclusive upper bound on glPerioddate
+	Descending    bool                            // Return the newest rows first
+	Latest        int                             // Only return the newest N rows per loan (0 returns all rows)
+	Columns       []string                        // Attributes to read from DynamoDB (nil reads every attribute)
+	Filter        *RowFilter[model.LoanCashFlow]  // Conditions on any column, pushed down to DynamoDB where possible
+	Sort          []SortField[model.LoanCashFlow] // Order of the returned rows (nil keeps loan and post date order)
+}
+
+// NewLoanCashFlowQuery builds a query from resolver arguments
+func NewLoanCashFlowQuery(startDate, endDate, glPeriodStart, glPeriodEnd *model.Date, descending bool, latest *int32) (LoanCashFlowQuery, error) {
+	query := LoanCashFlowQuery{
+		StartDate:     dateArgument(startDate),
+		EndDate:       dateArgument(endDate),
+		GLPeriodStart: dateArgument(glPeriodStart),
+		GLPeriodEnd:   dateArgument(glPeriodEnd),
+		Descending:    descending,
+	}
+
+	if query.StartDate != nil && query.EndDate != nil && query.StartDate.After(*query.EndDate) {
+		return query, fmt.Errorf("startDate must not be after endDate")
+	}
+	if query.GLPeriodStart != nil && query.GLPeriodEnd != nil && query.GLPeriodStart.After(*query.GLPeriodEnd) {
+		return query, fmt.Errorf("glPeriodStart must not be after glPeriodEnd")
+	}
+
+	if latest != nil {
+		if *latest <= 0 {
+			return query, fmt.Errorf("latest must be greater than zero")
+		}
+		query.Latest = int(*latest)
+	}
+
+	return query, nil
+}
+
+// dateArgument converts an optional Date argument
+func dateArgument(value *model.Date) *time.Time {
+	if value == nil {
+		return nil
+	}
+	date := value.Time()
+	return &date
+}
+
+// newestFirst reports whether DynamoDB should read the index backwards
+func (q LoanCashFlowQuery) newestFirst() bool {
+	return q.Descending || q.Latest > 0
+}
+
+// dateRangeCondition renders the StartDate/EndDate bounds against a postdate-prefixed attribute
+func (q LoanCashFlowQuery) dateRangeCondition(expr *expressionBuilder, attribute string) string {
+	var lower, upper string
+	if q.StartDate != nil {
+		lower = expr.value(&types.AttributeValueMemberS{Value: q.StartDate.Format("2006-01-02")})
+	}
+	if q.EndDate != nil {
+		upper = expr.value(&types.AttributeValueMemberS{Value: q.EndDate.Format("2006-01-02") + endOfDaySuffix})
+	}
+
+	switch {
+	case lower != "" && upper != "":
+		return fmt.Sprintf("%s BETWEEN %s AND %s", expr.name(attribute), lower, upper)
+	case lower != "":
+		return fmt.Sprintf("%s >= %s", expr.name(attribute), lower)
+	case upper != "":
+		return fmt.Sprintf("%s <= %s", expr.name(attribute), upper)
+	default:
+		return ""
+	}
+}
+
+// projectionExpression lists the attributes to read, or returns "" to read every attribute.
+// Key attributes and the columns the query evaluates in memory are always read; the item
+// decoder still drops any of them the caller may not see.
+func (q LoanCashFlowQuery) projectionExpression(expr *expressionBuilder) string {
+	if q.Columns == nil {
+		return ""
+	}
+
+	var attributes []string
+	add := func(attribute string) {
+		if !slices.Contains(attributes, attribute) {
+			attributes = append(attributes, attribute)
+		}
+	}
+
+	add("loancode")
+	add(sortKeyAttribute)
+	if q.GLPeriodStart != nil || q.GLPeriodEnd != nil {
+		add("glPerioddate")
+	}
+	// Key queries keep the most conditions in memory, so their attributes cover scans too
+	_, inMemory := q.split(true)
+	for _, condition := range inMemory {
+		for _, attribute := range condition.attributes() {
+			add(attribute)
+		}
+	}
+	for _, field := range q.Sort {
+		add(field.column.Attribute)
+	}
+	for _, column := range q.Columns {
+		add(column)
+	}
+
+	placeholders := make([]string, len(attributes))
+	for i, attribute := range attributes {
+		placeholders[i] = expr.name(attribute)
+	}
+	return strings.Join(placeholders, ", ")
+}
+
+// withFieldFilters returns the query narrowed by the caller's field filters, which are
+// pushed down to DynamoDB like any other filter condition
+func (q LoanCashFlowQuery) withFieldFilters(fieldFilters map[string]acl.FieldFilter) (LoanCashFlowQuery, error) {
+	fieldFilter, err := NewFieldFilter(LoanCashFlowColumns, fieldFilters)
+	if err != nil {
+		return q, err
+	}
+	q.Filter = andFilters(q.Filter, fieldFilter)
+	return q, nil
+}
+
+// split separates the filter conditions DynamoDB evaluates from the ones applied in memory.
+// keyed is set for queries on loanCodeIndexName, whose key attributes cannot be filtered on.
+func (q LoanCashFlowQuery) split(keyed bool) (pushed, inMemory []*RowFilter[model.LoanCashFlow]) {
+	if keyed {
+		return q.Filter.split(loanCodeIndexKeys...)
+	}
+	return q.Filter.split()
+}
+
+// filterExpression renders the filter conditions DynamoDB can evaluate, or "" if there are none
+func (q LoanCashFlowQuery) filterExpression(expr *expressionBuilder, keyed bool) string {
+	pushed, _ := q.split(keyed)
+	if len(pushed) == 0 {
+		return ""
+	}
+	return (&RowFilter[model.LoanCashFlow]{and: pushed}).expression(expr)
+}
+
+// accepts returns the check for the conditions DynamoDB cannot evaluate, applied to raw items
+// of a key query (keyed) or a scan.
+// glPerioddate is stored as it came from the source sheet, so it is compared after parsing.
+func (q LoanCashFlowQuery) accepts(keyed bool) func(item map[string]types.AttributeValue) bool {
+	_, inMemory := q.split(keyed)
+	return func(item map[string]types.AttributeValue) bool {
+		for _, condition := range inMemory {
+			if !condition.matches(item) {
+				return false
+			}
+		}
+
+		if q.GLPeriodStart == nil && q.GLPeriodEnd == nil {
+			return true
+		}
+
+		glPeriod, ok := parseStoredDate(item["glPerioddate"])
+		if !ok {
+			return false
+		}
+		if q.GLPeriodStart != nil && glPeriod.Before(*q.GLPeriodStart) {
+			return false
+		}
+		if q.GLPeriodEnd != nil && glPeriod.After(*q.GLPeriodEnd) {
+			return false
+		}
+
+		return true
+	}
+}
+
+// parseStoredDate reads a date column in any of the formats it is stored in
+func parseStoredDate(value types.AttributeValue) (time.Time, bool) {
+	s, ok := value.(*types.AttributeValueMemberS)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return parseDateValue(s.Value, storedDateLayouts)
+}
+
+// sortByLoanAndSortKey orders scanned items the way per-loan queries return them:
+// by loan code, then by the postdate#maxHmy sort key
+func sortByLoanAndSortKey(items []map[string]types.AttributeValue, descending bool) {
+	slices.SortStableFunc(items, func(a, b map[string]types.AttributeValue) int {
+		if c := strings.Compare(stringAttribute(a, "loancode"), stringAttribute(b, "loancode")); c != 0 {
+			return c
+		}
+		c := strings.Compare(stringAttribute(a, sortKeyAttribute), stringAttribute(b, sortKeyAttribute))
+		if descending {
+			return -c
+		}
+		return c
+	})
+}
+
+// keepLatestPerLoan keeps the first n items of each loan from items sorted newest first
+func keepLatestPerLoan(items []map[string]types.AttributeValue, n int) []map[string]types.AttributeValue {
+	kept := items[:0]
+	counts := make(map[string]int)
+	for _, item := range items {
+		loanCode := stringAttribute(item, "loancode")
+		if counts[loanCode] < n {
+			kept = append(kept, item)
+			counts[loanCode]++
+		}
+	}
+	return kept
+}
+
+// stringAttribute returns the string value of an attribute, or "" if it is missing or not a string
+func stringAttribute(item map[string]types.AttributeValue, name string) string {
+	if s, ok := item[name].(*types.AttributeValueMemberS); ok {
+		return s.Value
+	}
+	return ""
+}
+
+// expressionBuilder hands out placeholders for DynamoDB expressions so attribute names such
+// as "status" or "postdate#maxHmy" never clash with reserved words or expression syntax
+type expressionBuilder struct {
+	names  map[string]string
+	values map[string]types.AttributeValue
+}
+
+func newExpressionBuilder() *expressionBuilder {
+	return &expressionBuilder{
+		names:  make(map[string]string),
+		values: make(map[string]types.AttributeValue),
+	}
+}
+
+// name returns the placeholder for an attribute name, reusing it if already assigned
+func (b *expressionBuilder) name(attribute string) string {
+	for placeholder, existing := range b.names {
+		if existing == attribute {
+			return placeholder
+		}
+	}
+	placeholder := fmt.Sprintf("#n%d", len(b.names))
+	b.names[placeholder] = attribute
+	return placeholder
+}
+
+// value returns a new placeholder bound to the given value
+func (b *expressionBuilder) value(value types.AttributeValue) string {
+	placeholder := fmt.Sprintf(":v%d", len(b.values))
+	b.values[placeholder] = value
+	return placeholder
+}
+
+// attributeNames returns the ExpressionAttributeNames, or nil if none were used
+func (b *expressionBuilder) attributeNames() map[string]string {
+	if len(b.names) == 0 {
+		return nil
+	}
+	return b.names
+}
+
+// attributeValues returns the ExpressionAttributeValues, or nil if none were used
+func (b *expressionBuilder) attributeValues() map[string]types.AttributeValue {
+	if len(b.values) == 0 {
+		return nil
+	}
+	return b.values
+}
@@ -0,0 +1,26 @@
+package services
+
+import "ssot/gql/graphql/graph/model"
+
+// loanInfoKeyAttribute is the partition key of pbi-loaninfo
+const loanInfoKeyAttribute = "loancode"
+
+// LoanInfoColumns describes the pbi-loaninfo columns exposed on LoanInfo. The sync job names
+// attributes after the camel-cased Dim_LoanInfo headers and stores every value as S, so dates
+// and numbers are parsed on read. loanCode shares the "loancode" ACL name with LoanCashFlow,
+// so loancode field filters apply to both datasets.
+var LoanInfoColumns = NewColumnRegistry("LoanInfo",
+	Column[model.LoanInfo]{Attribute: loanInfoKeyAttribute, Field: "loanCode", Type: ColumnTypeString, Target: func(r *model.LoanInfo) any { return &r.LoanCode }},
+	Column[model.LoanInfo]{Attribute: "loanname", Field: "loanName", Type: ColumnTypeString, Target: func(r *model.LoanInfo) any { return &r.LoanName }},
+	Column[model.LoanInfo]{Attribute: "loanstatus", Field: "loanStatus", Type: ColumnTypeString, Target: func(r *model.LoanInfo) any { return &r.LoanStatus }},
+	Column[model.LoanInfo]{Attribute: "loantype", Field: "loanType", Type: ColumnTypeString, Target: func(r *model.LoanInfo) any { return &r.LoanType }},
+	Column[model.LoanInfo]{Attribute: "borrower", Field: "borrower", Type: ColumnTypeString, Target: func(r *model.LoanInfo) any { return &r.Borrower }},
+	Column[model.LoanInfo]{Attribute: "lender", Field: "lender", Type: ColumnTypeString, Target: func(r *model.LoanInfo) any { return &r.Lender }},
+	Column[model.LoanInfo]{Attribute: "fund", Field: "fund", Type: ColumnTypeString, Target: func(r *model.LoanInfo) any { return &r.Fund }},
+	Column[model.LoanInfo]{Attribute: "propertycode", Field: "propertyCode", Type: ColumnTypeString, Target: func(r *model.LoanInfo) any { return &r.PropertyCode }},
+	Column[model.LoanInfo]{Attribute: "propertyname", Field: "propertyName", Type: ColumnTypeString, Target: func(r *model.LoanInfo) any { return &r.PropertyName }},
+	Column[model.LoanInfo]{Attribute: "originationdate", Field: "originationDate", Type: ColumnTypeDate, Target: func(r *model.LoanInfo) any { return &r.OriginationDate }},
+	Column[model.LoanInfo]{Attribute: "maturitydate", Field: "maturityDate", Type: ColumnTypeDate, Target: func(r *model.LoanInfo) any { return &r.MaturityDate }},
+	Column[model.LoanInfo]{Attribute: "commitmentamount", Field: "commitmentAmount", Type: ColumnTypeNumberText, Target: func(r *model.LoanInfo) any { return &r.CommitmentAmount }},
+	Column[model.LoanInfo]{Attribute: "interestrate", Field: "interestRate", Type: ColumnTypeNumberText, Target: func(r *model.LoanInfo) any { return &r.InterestRate }},
+)
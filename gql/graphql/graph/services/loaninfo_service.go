@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"ssot/gql/graphql/graph/model"
+	"ssot/gql/graphql/internal/acl"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+const (
+	// maxBatchGetKeys is the most keys DynamoDB accepts in one BatchGetItem request
+	maxBatchGetKeys = 100
+	// maxBatchGetAttempts bounds how often unprocessed keys are retried before giving up
+	maxBatchGetAttempts = 5
+	// batchGetBackoff is the wait before the first retry of unprocessed keys; it doubles on each retry
+	batchGetBackoff = 50 * time.Millisecond
+)
+
+// LoanInfoService reads loan attributes from the pbi-loaninfo table, which holds one item per loan
+type LoanInfoService struct {
+	client    *dynamodb.Client
+	tableName string
+}
+
+func NewLoanInfoService(client *dynamodb.Client, tableName string) *LoanInfoService {
+	return &LoanInfoService{
+		client:    client,
+		tableName: tableName,
+	}
+}
+
+// GetLoanInfos returns the loans with the given codes, or every loan matching the filter if no
+// codes are given, ordered by loan code. Loan codes named by a top-level loanCode condition are
+// read by key instead of scanning the table. columns lists the attributes to read (nil reads all).
+func (s *LoanInfoService) GetLoanInfos(ctx context.Context, loanCodes []string, filter *RowFilter[model.LoanInfo], columns []string, columnPermissions *acl.ColumnPermissions, fieldFilters map[string]acl.FieldFilter) ([]*model.LoanInfo, error) {
+	fieldFilter, err := NewFieldFilter(LoanInfoColumns, fieldFilters)
+	if err != nil {
+		return nil, err
+	}
+	filter = andFilters(filter, fieldFilter)
+
+	if len(loanCodes) == 0 {
+		loanCodes = filter.keyValues(loanInfoKeyAttribute)
+	}
+
+	var items []map[string]types.AttributeValue
+	if len(loanCodes) > 0 {
+		items, err = s.batchGet(ctx, loanCodes, filter, columns)
+	} else {
+		items, err = s.scan(ctx, filter, columns)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Order by the raw key, which callers that cannot read loanCode still get results in
+	slices.SortFunc(items, func(a, b map[string]types.AttributeValue) int {
+		return strings.Compare(stringAttribute(a, loanInfoKeyAttribute), stringAttribute(b, loanInfoKeyAttribute))
+	})
+
+	loanInfos := make([]*model.LoanInfo, 0, len(items))
+	for _, item := range items {
+		if filter != nil && !filter.matches(item) {
+			continue
+		}
+
+		loanInfo, err := LoanInfoColumns.Decode(item, columnPermissions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert DynamoDB item: %w", err)
+		}
+		loanInfos = append(loanInfos, loanInfo)
+	}
+
+	return loanInfos, nil
+}
+
+// GetByLoanCodes reads the given loans by key, returning them by loan code. Loans missing from
+// the table or hidden by the caller's field filters are absent from the map. Every column the
+// caller may see is read, since one batch serves several selections.
+func (s *LoanInfoService) GetByLoanCodes(ctx context.Context, loanCodes []string, columnPermissions *acl.ColumnPermissions, fieldFilters map[string]acl.FieldFilter) (map[string]*model.LoanInfo, error) {
+	fieldFilter, err := NewFieldFilter(LoanInfoColumns, fieldFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.batchGet(ctx, loanCodes, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	loanInfos := make(map[string]*model.LoanInfo, len(items))
+	for _, item := range items {
+		if fieldFilter != nil && !fieldFilter.matches(item) {
+			continue
+		}
+
+		loanInfo, err := LoanInfoColumns.Decode(item, columnPermissions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert DynamoDB item: %w", err)
+		}
+		loanInfos[stringAttribute(item, loanInfoKeyAttribute)] = loanInfo
+	}
+
+	return loanInfos, nil
+}
+
+// batchGet reads the items of the given loan codes with BatchGetItem, retrying unprocessed keys
+func (s *LoanInfoService) batchGet(ctx context.Context, loanCodes []string, filter *RowFilter[model.LoanInfo], columns []string) ([]map[string]types.AttributeValue, error) {
+	codes := slices.Clone(loanCodes)
+	slices.Sort(codes)
+	codes = slices.Compact(codes)
+
+	var items []map[string]types.AttributeValue
+	for chunk := range slices.Chunk(codes, maxBatchGetKeys) {
+		keys := make([]map[string]types.AttributeValue, len(chunk))
+		for i, code := range chunk {
+			keys[i] = map[string]types.AttributeValue{
+				loanInfoKeyAttribute: &types.AttributeValueMemberS{Value: code},
+			}
+		}
+
+		expr := newExpressionBuilder()
+		request := types.KeysAndAttributes{Keys: keys}
+		if projection := loanInfoProjection(expr, filter, columns); projection != "" {
+			request.ProjectionExpression = aws.String(projection)
+			request.ExpressionAttributeNames = expr.attributeNames()
+		}
+
+		pending := map[string]types.KeysAndAttributes{s.tableName: request}
+		for attempt := 0; len(pending) > 0; attempt++ {
+			if attempt == maxBatchGetAttempts {
+				return nil, fmt.Errorf("failed to read loan info: keys still unprocessed after %d attempts", maxBatchGetAttempts)
+			}
+			if attempt > 0 {
+				select {
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				case <-time.After(batchGetBackoff << (attempt - 1)):
+				}
+			}
+
+			result, err := s.client.BatchGetItem(ctx, &dynamodb.BatchGetItemInput{RequestItems: pending})
+			if err != nil {
+				return nil, fmt.Errorf("failed to batch get DynamoDB items: %w", err)
+			}
+			items = append(items, result.Responses[s.tableName]...)
+			pending = result.UnprocessedKeys
+		}
+	}
+
+	return items, nil
+}
+
+// scan reads every loan, letting DynamoDB evaluate the filter conditions it can
+func (s *LoanInfoService) scan(ctx context.Context, filter *RowFilter[model.LoanInfo], columns []string) ([]map[string]types.AttributeValue, error) {
+	expr := newExpressionBuilder()
+	input := &dynamodb.ScanInput{
+		TableName: aws.String(s.tableName),
+	}
+
+	if pushed, _ := filter.split(); len(pushed) > 0 {
+		input.FilterExpression = aws.String((&RowFilter[model.LoanInfo]{and: pushed}).expression(expr))
+	}
+	if projection := loanInfoProjection(expr, filter, columns); projection != "" {
+		input.ProjectionExpression = aws.String(projection)
+	}
+	input.ExpressionAttributeNames = expr.attributeNames()
+	input.ExpressionAttributeValues = expr.attributeValues()
+
+	var items []map[string]types.AttributeValue
+	it := newScanIterator(s.client, input)
+	for it.HasMorePages() {
+		page, _, err := it.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+	}
+
+	return items, nil
+}
+
+// loanInfoProjection lists the attributes to read, or returns "" to read every attribute.
+// The key and the filtered attributes are always read, as results are checked and ordered by them.
+func loanInfoProjection(expr *expressionBuilder, filter *RowFilter[model.LoanInfo], columns []string) string {
+	if columns == nil {
+		return ""
+	}
+
+	attributes := []string{loanInfoKeyAttribute}
+	if filter != nil {
+		attributes = append(attributes, filter.attributes()...)
+	}
+	attributes = append(attributes, columns...)
+
+	var placeholders []string
+	for _, attribute := range attributes {
+		placeholder := expr.name(attribute)
+		if !slices.Contains(placeholders, placeholder) {
+			placeholders = append(placeholders, placeholder)
+		}
+	}
+	return strings.Join(placeholders, ", ")
+}
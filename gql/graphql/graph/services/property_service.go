@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"ssot/gql/graphql/graph/model"
+	"ssot/gql/graphql/internal/acl"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// propertyIndexAttributes are the attributes propertyCodeIndexName projects, which are all
+// a property lookup can filter on
+var propertyIndexAttributes = []string{"propertycode", loanInfoKeyAttribute, "propertyname"}
+
+// PropertyService reads properties, which have no table of their own: they are found through
+// the propertycode index of the pbi-loaninfo table
+type PropertyService struct {
+	client      *dynamodb.Client
+	tableName   string
+	concurrency int
+}
+
+func NewPropertyService(client *dynamodb.Client, loanInfoTableName string) *PropertyService {
+	return &PropertyService{
+		client:      client,
+		tableName:   loanInfoTableName,
+		concurrency: defaultConcurrency,
+	}
+}
+
+// GetByPropertyCodes looks up each property through the propertycode index, querying several
+// at once and returning them in the requested order. Properties come from pbi-loaninfo, so the
+// field filters that apply to LoanInfo narrow them, through the same conditions LoanInfo reads
+// use: a property's LoanCodes only lists the loans that pass the filters on propertycode,
+// loancode and propertyname, and properties with no such loans are left out. Filters on other
+// columns apply when the loans themselves are read.
+func (s *PropertyService) GetByPropertyCodes(ctx context.Context, propertyCodes []string, columnPermissions *acl.ColumnPermissions, fieldFilters map[string]acl.FieldFilter) ([]*model.Property, error) {
+	fieldFilter, err := NewFieldFilter(LoanInfoColumns, fieldFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	var conditions []*RowFilter[model.LoanInfo]
+	for _, conjunct := range fieldFilter.conjuncts() {
+		indexed := true
+		for _, attribute := range conjunct.attributes() {
+			indexed = indexed && slices.Contains(propertyIndexAttributes, attribute)
+		}
+		if indexed {
+			conditions = append(conditions, conjunct)
+		}
+	}
+	filter := andFilters(conditions...)
+
+	var codes []string
+	for _, propertyCode := range propertyCodes {
+		if !slices.Contains(codes, propertyCode) {
+			codes = append(codes, propertyCode)
+		}
+	}
+
+	found, _, err := fanOut(ctx, s.concurrency, codes, true, func(ctx context.Context, propertyCode string) (*model.Property, error) {
+		property, err := s.getByPropertyCode(ctx, propertyCode, filter, columnPermissions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get property %s: %w", propertyCode, err)
+		}
+		return property, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	properties := []*model.Property{}
+	for _, property := range found {
+		if property != nil {
+			properties = append(properties, property)
+		}
+	}
+
+	return properties, nil
+}
+
+// getByPropertyCode reads the loans of one property that pass filter from the index, or
+// returns nil if none do
+func (s *PropertyService) getByPropertyCode(ctx context.Context, propertyCode string, filter *RowFilter[model.LoanInfo], columnPermissions *acl.ColumnPermissions) (*model.Property, error) {
+	expr := newExpressionBuilder()
+	keyCondition := fmt.Sprintf("%s = %s", expr.name("propertycode"), expr.value(&types.AttributeValueMemberS{Value: propertyCode}))
+
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(s.tableName),
+		IndexName:              aws.String(propertyCodeIndexName),
+		KeyConditionExpression: aws.String(keyCondition),
+	}
+
+	// The index key can't be filtered on, so conditions on propertycode are matched in memory
+	pushed, inMemory := filter.split("propertycode")
+	if len(pushed) > 0 {
+		input.FilterExpression = aws.String((&RowFilter[model.LoanInfo]{and: pushed}).expression(expr))
+	}
+	input.ProjectionExpression = aws.String(loanInfoProjection(expr, andFilters(inMemory...), []string{"propertyname"}))
+	input.ExpressionAttributeNames = expr.attributeNames()
+	input.ExpressionAttributeValues = expr.attributeValues()
+
+	var items []map[string]types.AttributeValue
+	it := newQueryIterator(s.client, input)
+	for it.HasMorePages() {
+		page, _, err := it.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page {
+			if matchesAll(inMemory, item) {
+				items = append(items, item)
+			}
+		}
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	property := &model.Property{
+		PropertyCode: propertyCode,
+		LoanCodes:    []string{},
+	}
+	for _, item := range items {
+		if name := stringAttribute(item, "propertyname"); name != "" && property.PropertyName == nil && columnPermissions.IsAllowed("propertyname") {
+			property.PropertyName = &name
+		}
+		property.LoanCodes = append(property.LoanCodes, stringAttribute(item, loanInfoKeyAttribute))
+	}
+	slices.Sort(property.LoanCodes)
+
+	return property, nil
+}
+
+// matchesAll reports whether the item passes every condition
+func matchesAll[T any](conditions []*RowFilter[T], item map[string]types.AttributeValue) bool {
+	for _, condition := range conditions {
+		if !condition.matches(item) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"ssot/gql/graphql/internal/acl"
+	"ssot/gql/graphql/internal/dynamotest"
+)
+
+func TestGetByPropertyCodes(t *testing.T) {
+	loan := func(propertyCode, loanCode string) dynamotest.Item {
+		return dynamotest.Item{
+			"propertycode": dynamotest.S(propertyCode),
+			"propertyname": dynamotest.S("Tower " + propertyCode),
+			"loancode":     dynamotest.S(loanCode),
+		}
+	}
+	partitions := map[string][]dynamotest.Item{
+		"P1": {loan("P1", "L2-closed"), loan("P1", "L1")},
+		"P2": {loan("P2", "L3-closed")},
+		"P3": {loan("P3", "L4")},
+	}
+
+	server := dynamotest.NewServer(t)
+	server.Handle("Query", func(input map[string]any) (any, error) {
+		for _, value := range dynamotest.StringValues(input) {
+			if items, ok := partitions[value]; ok {
+				return dynamotest.Page(items, input, "propertycode", "loancode"), nil
+			}
+		}
+		return dynamotest.Page(nil, input), nil
+	})
+	service := NewPropertyService(server.Client(), "loaninfo")
+
+	fieldFilters := map[string]acl.FieldFilter{
+		"propertycode": {IncludeList: []string{"P1", "P2"}},
+		"loancode":     {ExcludeList: []string{"*-closed"}},
+		"propertyname": {ExcludeList: []string{"Annex"}},
+		"status":       {IncludeList: []string{"Active"}}, // Not in the index, applied when loans are read
+	}
+
+	properties, err := service.GetByPropertyCodes(context.Background(), []string{"P3", "P1", "P2", "P1"}, allowAll(LoanInfoColumns), fieldFilters)
+	if err != nil {
+		t.Fatalf("GetByPropertyCodes: %v", err)
+	}
+
+	if len(properties) != 1 || properties[0].PropertyCode != "P1" {
+		t.Fatalf("got %d properties, want only P1", len(properties))
+	}
+	if !slices.Equal(properties[0].LoanCodes, []string{"L1"}) {
+		t.Errorf("P1 loan codes = %v, want [L1]", properties[0].LoanCodes)
+	}
+	if name := properties[0].PropertyName; name == nil || *name != "Tower P1" {
+		t.Errorf("P1 name = %v, want Tower P1", name)
+	}
+
+	requests := server.Requests("Query")
+	if len(requests) != 3 {
+		t.Fatalf("ran %d queries, want one per distinct property code", len(requests))
+	}
+	for _, request := range requests {
+		expression, _ := request["FilterExpression"].(string)
+		if !strings.Contains(expression, "NOT") {
+			t.Errorf("filter expression %q does not exclude the Annex property name", expression)
+		}
+		projection, _ := request["ProjectionExpression"].(string)
+		if strings.Count(projection, ",") != 2 {
+			t.Errorf("projection %q, want propertycode, loancode and propertyname only", projection)
+		}
+	}
+}
@@ -0,0 +1,515 @@
+package services
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"ssot/gql/graphql/graph/model"
+	"ssot/gql/graphql/internal/acl"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+const (
+	// maxInOperands is the most values DynamoDB accepts in an IN comparison
+	maxInOperands = 100
+	// maxFilterDepth bounds how deeply and/or conditions may nest
+	maxFilterDepth = 8
+)
+
+// RowFilter is a validated filter input whose values are typed by their column in a registry
+type RowFilter[T any] struct {
+	column Column[T]
+	eq     *filterValue
+	in     []filterValue
+	prefix *filterValue      // Only built from field filters' prefix patterns
+	match  *acl.ValuePattern // Only built from field filters' glob and regex patterns
+	gt     *filterValue
+	gte    *filterValue
+	lt     *filterValue
+	lte    *filterValue
+	and    []*RowFilter[T]
+	or     []*RowFilter[T]
+	not    *RowFilter[T] // Only built from field filters' exclude lists
+}
+
+// filterValue is a filter argument parsed for its column's type
+type filterValue struct {
+	text   string
+	number model.Decimal
+	date   time.Time
+}
+
+// SortField orders rows by one column
+type SortField[T any] struct {
+	column     Column[T]
+	descending bool
+}
+
+// NewRowFilter validates a filter against the column registry and the caller's column permissions.
+// Filtering on a column the caller cannot read is rejected, since the result would reveal its values.
+func NewRowFilter[T any](registry *ColumnRegistry[T], filter *model.RowFilterInput, columnPermissions *acl.ColumnPermissions) (*RowFilter[T], error) {
+	if filter == nil {
+		return nil, nil
+	}
+	return newRowFilter(registry, filter, columnPermissions, 1)
+}
+
+func newRowFilter[T any](registry *ColumnRegistry[T], filter *model.RowFilterInput, columnPermissions *acl.ColumnPermissions, depth int) (*RowFilter[T], error) {
+	if depth > maxFilterDepth {
+		return nil, fmt.Errorf("filter: conditions nest deeper than %d levels", maxFilterDepth)
+	}
+
+	set := 0
+	for _, present := range []bool{filter.Field != nil, filter.And != nil, filter.Or != nil} {
+		if present {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, fmt.Errorf("filter: set exactly one of field, and, or")
+	}
+
+	if filter.And != nil || filter.Or != nil {
+		if filter.Eq != nil || filter.In != nil || filter.Range != nil {
+			return nil, fmt.Errorf("filter: eq, in and range need a field")
+		}
+
+		children := filter.And
+		if filter.Or != nil {
+			children = filter.Or
+		}
+		if len(children) == 0 {
+			return nil, fmt.Errorf("filter: and/or need at least one condition")
+		}
+
+		compiled := make([]*RowFilter[T], len(children))
+		for i, child := range children {
+			node, err := newRowFilter(registry, child, columnPermissions, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			compiled[i] = node
+		}
+
+		if filter.And != nil {
+			return &RowFilter[T]{and: compiled}, nil
+		}
+		return &RowFilter[T]{or: compiled}, nil
+	}
+
+	column, err := registry.Readable(*filter.Field, columnPermissions)
+	if err != nil {
+		return nil, fmt.Errorf("filter: %w", err)
+	}
+
+	node := &RowFilter[T]{column: column}
+	operators := 0
+	if filter.Eq != nil {
+		operators++
+		if node.eq, err = parseFilterValue(column, *filter.Eq); err != nil {
+			return nil, err
+		}
+	}
+	if filter.In != nil {
+		operators++
+		if len(filter.In) == 0 {
+			return nil, fmt.Errorf("filter: in on field %s needs at least one value", column.Field)
+		}
+		for _, value := range filter.In {
+			parsed, err := parseFilterValue(column, value)
+			if err != nil {
+				return nil, err
+			}
+			node.in = append(node.in, *parsed)
+		}
+	}
+	if filter.Range != nil {
+		operators++
+		bounds := []struct {
+			value  *string
+			target **filterValue
+		}{
+			{filter.Range.Gt, &node.gt},
+			{filter.Range.Gte, &node.gte},
+			{filter.Range.Lt, &node.lt},
+			{filter.Range.Lte, &node.lte},
+		}
+		empty := true
+		for _, bound := range bounds {
+			if bound.value == nil {
+				continue
+			}
+			empty = false
+			if *bound.target, err = parseFilterValue(column, *bound.value); err != nil {
+				return nil, err
+			}
+		}
+		if empty {
+			return nil, fmt.Errorf("filter: range on field %s needs at least one bound", column.Field)
+		}
+		if node.gt != nil && node.gte != nil || node.lt != nil && node.lte != nil {
+			return nil, fmt.Errorf("filter: range on field %s sets both an inclusive and an exclusive bound", column.Field)
+		}
+	}
+	if operators != 1 {
+		return nil, fmt.Errorf("filter: field %s needs exactly one of eq, in, range", column.Field)
+	}
+
+	return node, nil
+}
+
+// parseFilterValue reads a filter argument as the column's type
+func parseFilterValue[T any](column Column[T], value string) (*filterValue, error) {
+	parsed := &filterValue{text: value}
+
+	switch column.Type {
+	case ColumnTypeNumber, ColumnTypeNumberText:
+		number, err := model.ParseDecimal(value)
+		if err != nil {
+			return nil, fmt.Errorf("filter: field %s: %w", column.Field, err)
+		}
+		parsed.number = number
+		parsed.text = number.String()
+	case ColumnTypeDate:
+		date, err := model.ParseDate(value)
+		if err != nil {
+			return nil, fmt.Errorf("filter: field %s: %w", column.Field, err)
+		}
+		parsed.date = date.Time()
+	case ColumnTypeDateTime:
+		dateTime, err := model.ParseDateTime(value)
+		if err != nil {
+			return nil, fmt.Errorf("filter: field %s: %w", column.Field, err)
+		}
+		// Pushed-down comparisons match the stored, sortable form
+		parsed.date = dateTime.Time()
+		parsed.text = dateTime.String()
+	}
+
+	return parsed, nil
+}
+
+// parseDateValue parses a date in the first of layouts that matches
+func parseDateValue(value string, layouts []string) (time.Time, bool) {
+	for _, layout := range layouts {
+		if parsed, err := time.Parse(layout, strings.TrimSpace(value)); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// pushable reports whether DynamoDB can evaluate the whole condition. Date columns keep the
+// source sheet's format and number text is stored as S, so both only compare correctly after
+// parsing. Key attributes of a Query cannot appear in its filter expression. begins_with only
+// applies to strings, and globs and regular expressions have no DynamoDB equivalent.
+func (f *RowFilter[T]) pushable(keyAttributes []string) bool {
+	for _, child := range f.children() {
+		if !child.pushable(keyAttributes) {
+			return false
+		}
+	}
+	if !f.isLeaf() {
+		return true
+	}
+	if f.match != nil || f.prefix != nil && f.column.Type != ColumnTypeString {
+		return false
+	}
+	return f.column.Type != ColumnTypeDate && f.column.Type != ColumnTypeNumberText &&
+		!slices.Contains(keyAttributes, f.column.Attribute) &&
+		len(f.in) <= maxInOperands
+}
+
+// isLeaf reports whether the condition tests a single column rather than combining conditions
+func (f *RowFilter[T]) isLeaf() bool {
+	return f.and == nil && f.or == nil && f.not == nil
+}
+
+// children returns the conditions a non-leaf condition combines
+func (f *RowFilter[T]) children() []*RowFilter[T] {
+	children := slices.Concat(f.and, f.or)
+	if f.not != nil {
+		children = append(children, f.not)
+	}
+	return children
+}
+
+// conjuncts returns the conditions that must all hold
+func (f *RowFilter[T]) conjuncts() []*RowFilter[T] {
+	if f == nil {
+		return nil
+	}
+	if f.and != nil {
+		var all []*RowFilter[T]
+		for _, child := range f.and {
+			all = append(all, child.conjuncts()...)
+		}
+		return all
+	}
+	return []*RowFilter[T]{f}
+}
+
+// split separates the conditions DynamoDB evaluates from the ones applied in memory.
+// Conditions on keyAttributes stay in memory.
+func (f *RowFilter[T]) split(keyAttributes ...string) (pushed, inMemory []*RowFilter[T]) {
+	for _, conjunct := range f.conjuncts() {
+		if conjunct.pushable(keyAttributes) {
+			pushed = append(pushed, conjunct)
+		} else {
+			inMemory = append(inMemory, conjunct)
+		}
+	}
+	return pushed, inMemory
+}
+
+// andFilters combines conditions that must all hold, skipping nil ones
+func andFilters[T any](filters ...*RowFilter[T]) *RowFilter[T] {
+	var present []*RowFilter[T]
+	for _, filter := range filters {
+		if filter != nil {
+			present = append(present, filter)
+		}
+	}
+	switch len(present) {
+	case 0:
+		return nil
+	case 1:
+		return present[0]
+	}
+	return &RowFilter[T]{and: present}
+}
+
+// keyValues returns the values a top-level eq/in condition on attribute restricts rows to,
+// so rows can be read by key instead of with a table scan
+func (f *RowFilter[T]) keyValues(attribute string) []string {
+	for _, conjunct := range f.conjuncts() {
+		if conjunct.column.Attribute != attribute {
+			continue
+		}
+		if conjunct.eq != nil {
+			return []string{conjunct.eq.text}
+		}
+		if conjunct.in != nil {
+			values := make([]string, len(conjunct.in))
+			for i, value := range conjunct.in {
+				values[i] = value.text
+			}
+			return values
+		}
+	}
+	return nil
+}
+
+// attributes returns the DynamoDB attributes the condition reads
+func (f *RowFilter[T]) attributes() []string {
+	var attributes []string
+	for _, child := range f.children() {
+		attributes = append(attributes, child.attributes()...)
+	}
+	if f.isLeaf() {
+		attributes = append(attributes, f.column.Attribute)
+	}
+	return attributes
+}
+
+// expression renders the condition as a DynamoDB condition expression
+func (f *RowFilter[T]) expression(expr *expressionBuilder) string {
+	if f.and != nil || f.or != nil {
+		operator, children := " AND ", f.and
+		if f.or != nil {
+			operator, children = " OR ", f.or
+		}
+		parts := make([]string, len(children))
+		for i, child := range children {
+			parts[i] = "(" + child.expression(expr) + ")"
+		}
+		return strings.Join(parts, operator)
+	}
+	if f.not != nil {
+		return "NOT (" + f.not.expression(expr) + ")"
+	}
+
+	name := expr.name(f.column.Attribute)
+	switch {
+	case f.eq != nil:
+		return fmt.Sprintf("%s = %s", name, expr.value(f.attributeValue(*f.eq)))
+	case f.in != nil:
+		values := make([]string, len(f.in))
+		for i, value := range f.in {
+			values[i] = expr.value(f.attributeValue(value))
+		}
+		return fmt.Sprintf("%s IN (%s)", name, strings.Join(values, ", "))
+	case f.prefix != nil:
+		return fmt.Sprintf("begins_with(%s, %s)", name, expr.value(f.attributeValue(*f.prefix)))
+	case f.gte != nil && f.lte != nil:
+		return fmt.Sprintf("%s BETWEEN %s AND %s", name, expr.value(f.attributeValue(*f.gte)), expr.value(f.attributeValue(*f.lte)))
+	}
+
+	var bounds []string
+	for _, bound := range []struct {
+		operator string
+		value    *filterValue
+	}{{">", f.gt}, {">=", f.gte}, {"<", f.lt}, {"<=", f.lte}} {
+		if bound.value != nil {
+			bounds = append(bounds, fmt.Sprintf("%s %s %s", name, bound.operator, expr.value(f.attributeValue(*bound.value))))
+		}
+	}
+	return strings.Join(bounds, " AND ")
+}
+
+// attributeValue converts a filter value to the DynamoDB type the column is stored as
+func (f *RowFilter[T]) attributeValue(value filterValue) types.AttributeValue {
+	if f.column.Type == ColumnTypeNumber {
+		return &types.AttributeValueMemberN{Value: value.text}
+	}
+	return &types.AttributeValueMemberS{Value: value.text}
+}
+
+// matches evaluates the condition against a raw DynamoDB item
+func (f *RowFilter[T]) matches(item map[string]types.AttributeValue) bool {
+	if f.and != nil {
+		for _, child := range f.and {
+			if !child.matches(item) {
+				return false
+			}
+		}
+		return true
+	}
+	if f.or != nil {
+		for _, child := range f.or {
+			if child.matches(item) {
+				return true
+			}
+		}
+		return false
+	}
+	if f.not != nil {
+		return !f.not.matches(item)
+	}
+
+	stored, ok := readFilterValue(f.column, item[f.column.Attribute])
+	if !ok {
+		// As in DynamoDB, a missing value satisfies no comparison
+		return false
+	}
+
+	compare := func(value filterValue) int { return compareFilterValues(f.column.Type, stored, value) }
+	switch {
+	case f.eq != nil:
+		return compare(*f.eq) == 0
+	case f.in != nil:
+		return slices.ContainsFunc(f.in, func(value filterValue) bool { return compare(value) == 0 })
+	case f.prefix != nil:
+		return strings.HasPrefix(stored.text, f.prefix.text)
+	case f.match != nil:
+		return f.match.Matches(stored.text)
+	}
+	return (f.gt == nil || compare(*f.gt) > 0) &&
+		(f.gte == nil || compare(*f.gte) >= 0) &&
+		(f.lt == nil || compare(*f.lt) < 0) &&
+		(f.lte == nil || compare(*f.lte) <= 0)
+}
+
+// readFilterValue reads an item attribute as the column's type
+func readFilterValue[T any](column Column[T], value types.AttributeValue) (filterValue, bool) {
+	var text string
+	switch v := value.(type) {
+	case *types.AttributeValueMemberS:
+		text = v.Value
+	case *types.AttributeValueMemberN:
+		text = v.Value
+	default:
+		return filterValue{}, false
+	}
+
+	parsed := filterValue{text: text}
+	switch column.Type {
+	case ColumnTypeNumber, ColumnTypeNumberText:
+		number, err := model.ParseDecimal(text)
+		if err != nil {
+			return parsed, false
+		}
+		parsed.number = number
+	case ColumnTypeDate, ColumnTypeDateTime:
+		date, ok := parseDateValue(text, storedDateLayouts)
+		if !ok {
+			return parsed, false
+		}
+		parsed.date = date
+	}
+
+	return parsed, true
+}
+
+// compareFilterValues orders two values of the given column type
+func compareFilterValues(columnType ColumnType, a, b filterValue) int {
+	switch columnType {
+	case ColumnTypeNumber, ColumnTypeNumberText:
+		return a.number.Cmp(b.number)
+	case ColumnTypeDate, ColumnTypeDateTime:
+		return a.date.Compare(b.date)
+	default:
+		return strings.Compare(a.text, b.text)
+	}
+}
+
+// NewSortFields validates the requested sort order against the registry and column permissions
+func NewSortFields[T any](registry *ColumnRegistry[T], sort []*model.LoanCashFlowSort, columnPermissions *acl.ColumnPermissions) ([]SortField[T], error) {
+	fields := make([]SortField[T], 0, len(sort))
+	for _, field := range sort {
+		column, err := registry.Readable(field.Field, columnPermissions)
+		if err != nil {
+			return nil, fmt.Errorf("sort: %w", err)
+		}
+		fields = append(fields, SortField[T]{
+			column:     column,
+			descending: field.Order != nil && *field.Order == model.SortOrderDesc,
+		})
+	}
+	return fields, nil
+}
+
+// sortRows orders decoded rows by the sort fields; rows missing a value sort last
+func sortRows[T any](rows []*T, sort []SortField[T]) {
+	if len(sort) == 0 {
+		return
+	}
+
+	slices.SortStableFunc(rows, func(a, b *T) int {
+		for _, field := range sort {
+			textA, textB := field.column.Text(a), field.column.Text(b)
+			if textA == nil || textB == nil {
+				if c := cmp.Compare(boolRank(textA == nil), boolRank(textB == nil)); c != 0 {
+					return c
+				}
+				continue
+			}
+
+			valueA, okA := readFilterValue(field.column, &types.AttributeValueMemberS{Value: *textA})
+			valueB, okB := readFilterValue(field.column, &types.AttributeValueMemberS{Value: *textB})
+			c := cmp.Compare(boolRank(!okA), boolRank(!okB))
+			if c == 0 && okA {
+				c = compareFilterValues(field.column.Type, valueA, valueB)
+			}
+			if field.descending {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+}
+
+// boolRank orders false before true
+func boolRank(value bool) int {
+	if value {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"ssot/gql/graphql/graph/model"
+	"ssot/gql/graphql/internal/acl"
+	"ssot/gql/graphql/internal/dynamotest"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+func eqFilter(field, value string) *model.RowFilterInput {
+	return &model.RowFilterInput{Field: aws.String(field), Eq: aws.String(value)}
+}
+
+func rangeFilter(field string, gte, lt *string) *model.RowFilterInput {
+	return &model.RowFilterInput{Field: aws.String(field), Range: &model.FilterRange{Gte: gte, Lt: lt}}
+}
+
+func TestRowFilterSplit(t *testing.T) {
+	manyLoanCodes := make([]string, maxInOperands+1)
+	for i := range manyLoanCodes {
+		manyLoanCodes[i] = fmt.Sprintf("L%d", i)
+	}
+
+	tests := []struct {
+		name         string
+		filter       *model.RowFilterInput
+		fieldFilters map[string]acl.FieldFilter
+		keyed        bool
+		wantPushed   int
+		wantInMemory int
+	}{
+		{name: "string equality", filter: eqFilter("status", "Active"), wantPushed: 1},
+		{name: "number range", filter: rangeFilter("balance", aws.String("10"), nil), wantPushed: 1},
+		{name: "date columns compare after parsing", filter: rangeFilter("accrualEndDate", aws.String("2024-01-01"), nil), wantInMemory: 1},
+		{name: "key attribute of a scan", filter: eqFilter("loanCode", "L1"), wantPushed: 1},
+		{name: "key attribute of a query", filter: eqFilter("loanCode", "L1"), keyed: true, wantInMemory: 1},
+		{name: "too many IN operands", filter: &model.RowFilterInput{Field: aws.String("loanCode"), In: manyLoanCodes}, wantInMemory: 1},
+		{
+			name:         "and splits into conjuncts",
+			filter:       &model.RowFilterInput{And: []*model.RowFilterInput{eqFilter("status", "Active"), rangeFilter("accrualEndDate", aws.String("2024-01-01"), nil)}},
+			wantPushed:   1,
+			wantInMemory: 1,
+		},
+		{
+			name:         "or stays whole",
+			filter:       &model.RowFilterInput{Or: []*model.RowFilterInput{eqFilter("status", "Active"), rangeFilter("accrualEndDate", aws.String("2024-01-01"), nil)}},
+			wantInMemory: 1,
+		},
+		{name: "prefix field filter", fieldFilters: map[string]acl.FieldFilter{"status": {IncludeList: []string{"Act*"}}}, wantPushed: 1},
+		{name: "glob field filter", fieldFilters: map[string]acl.FieldFilter{"status": {IncludeList: []string{"A*e"}}}, wantInMemory: 1},
+		{name: "exclude list", fieldFilters: map[string]acl.FieldFilter{"status": {ExcludeList: []string{"Closed"}}}, wantPushed: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var query LoanCashFlowQuery
+			var err error
+			if query.Filter, err = NewRowFilter(LoanCashFlowColumns, tt.filter, allowAll(LoanCashFlowColumns)); err != nil {
+				t.Fatalf("NewRowFilter: %v", err)
+			}
+			if query, err = query.withFieldFilters(tt.fieldFilters); err != nil {
+				t.Fatalf("withFieldFilters: %v", err)
+			}
+
+			pushed, inMemory := query.split(tt.keyed)
+			if len(pushed) != tt.wantPushed || len(inMemory) != tt.wantInMemory {
+				t.Errorf("split = %d pushed, %d in memory, want %d and %d", len(pushed), len(inMemory), tt.wantPushed, tt.wantInMemory)
+			}
+		})
+	}
+}
+
+func TestRowFilterMatches(t *testing.T) {
+	item := map[string]types.AttributeValue{
+		"status":         &types.AttributeValueMemberS{Value: "Active"},
+		"balance":        &types.AttributeValueMemberN{Value: "9"},
+		"accrualenddate": &types.AttributeValueMemberS{Value: "1/15/2024 12:00:00 AM"},
+	}
+
+	tests := []struct {
+		name         string
+		filter       *model.RowFilterInput
+		fieldFilters map[string]acl.FieldFilter
+		want         bool
+	}{
+		{name: "numbers compare as numbers", filter: rangeFilter("balance", nil, aws.String("10")), want: true},
+		{name: "dates compare across stored formats", filter: rangeFilter("accrualEndDate", aws.String("2024-01-01"), aws.String("2024-02-01")), want: true},
+		{name: "date outside the range", filter: rangeFilter("accrualEndDate", aws.String("2024-02-01"), nil), want: false},
+		{name: "missing attribute matches nothing", filter: eqFilter("loanDesc", "x"), want: false},
+		{
+			name:   "or of a miss and a hit",
+			filter: &model.RowFilterInput{Or: []*model.RowFilterInput{eqFilter("status", "Closed"), rangeFilter("balance", aws.String("5"), nil)}},
+			want:   true,
+		},
+		{name: "glob field filter", fieldFilters: map[string]acl.FieldFilter{"status": {IncludeList: []string{"A*e"}}}, want: true},
+		{name: "exclude list", fieldFilters: map[string]acl.FieldFilter{"status": {ExcludeList: []string{"Act*"}}}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := NewRowFilter(LoanCashFlowColumns, tt.filter, allowAll(LoanCashFlowColumns))
+			if err != nil {
+				t.Fatalf("NewRowFilter: %v", err)
+			}
+			fieldFilter, err := NewFieldFilter(LoanCashFlowColumns, tt.fieldFilters)
+			if err != nil {
+				t.Fatalf("NewFieldFilter: %v", err)
+			}
+
+			if got := andFilters(filter, fieldFilter).matches(item); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryPushesDownAndFiltersInMemory(t *testing.T) {
+	items := []dynamotest.Item{cashFlowItem("L1", "1"), cashFlowItem("L1", "2"), cashFlowItem("L1", "3")}
+	items[0]["accrualenddate"] = dynamotest.S("2023-12-31")
+	items[1]["accrualenddate"] = dynamotest.S("1/15/2024 12:00:00 AM")
+	items[2]["accrualenddate"] = dynamotest.S("2024-03-01T00:00:00")
+
+	// The fake ignores the filter expression, so only the in-memory conditions drop rows
+	server := dynamotest.NewServer(t)
+	server.Handle("Query", func(input map[string]any) (any, error) {
+		return dynamotest.Page(items, input, "loancode", sortKeyAttribute), nil
+	})
+	service := NewLoanCashFlowService(server.Client(), "loancashflow", LoanCashFlowOptions{})
+
+	filter, err := NewRowFilter(LoanCashFlowColumns, &model.RowFilterInput{And: []*model.RowFilterInput{
+		eqFilter("status", "Active"),
+		rangeFilter("accrualEndDate", aws.String("2024-01-01"), aws.String("2024-02-01")),
+	}}, allowAll(LoanCashFlowColumns))
+	if err != nil {
+		t.Fatalf("NewRowFilter: %v", err)
+	}
+
+	rows, err := service.GetByLoanCodesWithQuery(context.Background(), []*string{aws.String("L1")}, LoanCashFlowQuery{Filter: filter}, allowAll(LoanCashFlowColumns))
+	if err != nil {
+		t.Fatalf("GetByLoanCodesWithQuery: %v", err)
+	}
+	if len(rows) != 1 || *rows[0].MaxHmy != "2" {
+		t.Errorf("got %d rows, want only maxHmy 2", len(rows))
+	}
+
+	requests := server.Requests("Query")
+	if len(requests) != 1 {
+		t.Fatalf("ran %d queries, want 1", len(requests))
+	}
+	expression, _ := requests[0]["FilterExpression"].(string)
+	names, _ := requests[0]["ExpressionAttributeNames"].(map[string]any)
+	var filtered []string
+	for placeholder, name := range names {
+		if strings.Contains(expression, placeholder) {
+			filtered = append(filtered, name.(string))
+		}
+	}
+	if len(filtered) != 1 || filtered[0] != "status" {
+		t.Errorf("filter expression %q reads %v, want only status", expression, filtered)
+	}
+}
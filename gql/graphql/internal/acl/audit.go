@@ -0,0 +1,177 @@
+package acl
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+)
+
+// AuditOperation names the change an audit entry records
+type AuditOperation string
+
+const (
+	AuditCreateUser                 AuditOperation = "create_user"
+	AuditUpdateUserGroups           AuditOperation = "update_user_groups"
+	AuditUpdateUserPermissions      AuditOperation = "update_user_permissions"
+	AuditUpdateUser                 AuditOperation = "update_user" // Several parts of a user record at once
+	AuditDeleteUser                 AuditOperation = "delete_user"
+	AuditCreateGroup                AuditOperation = "create_group"
+	AuditUpdateGroupPermissions     AuditOperation = "update_group_permissions"
+	AuditUpdateGroupParents         AuditOperation = "update_group_parents"
+	AuditUpdateGroupMergeStrategies AuditOperation = "update_group_merge_strategies"
+	AuditUpdateGroup                AuditOperation = "update_group" // Several parts of a group record at once
+	AuditDeleteGroup                AuditOperation = "delete_group"
+	AuditRollback                   AuditOperation = "rollback"
+	AuditImport                     AuditOperation = "import"
+)
+
+// auditTimeLayout is a fixed-width timestamp, so entry IDs sort chronologically
+const auditTimeLayout = "2006-01-02T15:04:05.000000000Z"
+
+// AuditEntry records one change to an ACL record. Entries are only ever added, never
+// updated or deleted.
+type AuditEntry struct {
+	PrincipalID string // The user or group changed
+	EntryID     string // Timestamp and a random suffix, unique per principal
+	Actor       string // Email of the administrator who made the change
+	Timestamp   time.Time
+	Operation   AuditOperation
+	Version     int // The record version the change produced
+	Changes     []AuditChange
+}
+
+// AuditChange is one changed attribute of an ACL record, such as "Groups" or
+// "Permissions.LoanCashFlow#*". Before is empty for added attributes and After for removed ones.
+type AuditChange struct {
+	Path   string
+	Before string
+	After  string
+}
+
+// AuditFilter narrows the audit entries listed; zero fields match every entry
+type AuditFilter struct {
+	PrincipalID string
+	Actor       string
+	Operation   AuditOperation
+	From        time.Time // Inclusive
+	Until       time.Time // Exclusive
+	Limit       int       // Most entries returned, newest first (0 means all)
+}
+
+// newAuditEntry records the change from before to after made by the user of ctx. A nil
+// before records a creation, a nil after a deletion.
+func newAuditEntry(ctx context.Context, operation AuditOperation, before, after *ACLRecord) (*AuditEntry, error) {
+	actor, err := GetUserEmail(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to identify the user making the change: %w", err)
+	}
+
+	suffix := make([]byte, 8)
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, fmt.Errorf("failed to generate audit entry ID: %w", err)
+	}
+
+	principalID := ""
+	for _, record := range []*ACLRecord{after, before} {
+		if record != nil {
+			principalID = record.PrincipalID
+			break
+		}
+	}
+
+	now := time.Now().UTC()
+	return &AuditEntry{
+		PrincipalID: principalID,
+		EntryID:     now.Format(auditTimeLayout) + "#" + hex.EncodeToString(suffix),
+		Actor:       actor,
+		Timestamp:   now,
+		Operation:   operation,
+		Changes:     DiffACLRecords(before, after),
+	}, nil
+}
+
+// DiffACLRecords lists the attributes that differ between two versions of a record, in path
+// order. Either version may be nil.
+func DiffACLRecords(before, after *ACLRecord) []AuditChange {
+	beforeValues, afterValues := auditValues(before), auditValues(after)
+
+	paths := slices.Sorted(maps.Keys(beforeValues))
+	for path := range afterValues {
+		if _, ok := beforeValues[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	slices.Sort(paths)
+
+	var changes []AuditChange
+	for _, path := range paths {
+		if beforeValues[path] != afterValues[path] {
+			changes = append(changes, AuditChange{
+				Path:   path,
+				Before: beforeValues[path],
+				After:  afterValues[path],
+			})
+		}
+	}
+	return changes
+}
+
+// auditValues flattens a record into its attributes by path, rendering each value as JSON
+func auditValues(record *ACLRecord) map[string]string {
+	values := make(map[string]string)
+	if record == nil {
+		return values
+	}
+
+	set := func(path string, value any) {
+		encoded, _ := json.Marshal(value) // Strings, lists and maps of strings always encode
+		values[path] = string(encoded)
+	}
+
+	if len(record.Groups) > 0 {
+		set("Groups", record.Groups)
+	}
+	for key, action := range record.Permissions {
+		set("Permissions."+key, action)
+	}
+	for key, validity := range record.PermissionValidity {
+		set("PermissionValidity."+key, auditValidity(validity))
+	}
+	for group, validity := range record.GroupValidity {
+		set("GroupValidity."+group, auditValidity(validity))
+	}
+	for key, filter := range record.FieldFilters {
+		set("FieldFilters."+key, struct {
+			Field       string
+			FilterType  string   `json:",omitempty"`
+			IncludeList []string `json:",omitempty"`
+			ExcludeList []string `json:",omitempty"`
+			Validity    any      `json:",omitempty"`
+		}{filter.Field, filter.FilterType, filter.IncludeList, filter.ExcludeList, auditValidity(filter.Validity)})
+	}
+	for key, strategy := range record.MergeStrategies {
+		set("MergeStrategies."+key, strategy)
+	}
+
+	return values
+}
+
+// auditValidity renders the set bounds of a validity window, or nil if it has none
+func auditValidity(validity Validity) any {
+	if validity == (Validity{}) {
+		return nil
+	}
+	bounds := make(map[string]string)
+	if !validity.ValidFrom.IsZero() {
+		bounds["ValidFrom"] = validity.ValidFrom.UTC().Format(time.RFC3339)
+	}
+	if !validity.ValidUntil.IsZero() {
+		bounds["ValidUntil"] = validity.ValidUntil.UTC().Format(time.RFC3339)
+	}
+	return bounds
+}
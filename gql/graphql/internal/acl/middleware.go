@@ -2,6 +2,7 @@ package acl
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
@@ -9,6 +10,10 @@ import (
 	"ssot/gql/graphql/internal/auth/middleware"
 )
 
+// ErrAccessDenied is wrapped by the errors of checks the caller fails, as opposed to checks
+// that could not be made
+var ErrAccessDenied = errors.New("access denied")
+
 // ACLMiddleware provides permission checking for GraphQL resolvers
 type ACLMiddleware struct {
 	service *ACLService
@@ -35,10 +40,10 @@ func (m *ACLMiddleware) CheckPermission(ctx context.Context, table, column, acti
 		return fmt.Errorf("failed to get user permissions: %w", err)
 	}
 
-	// Check if user has required permission
-	if !acl.CanAccess(table, action) {
-		return fmt.Errorf("access denied: user %s does not have %s permission for %s",
-			user.Email, action, table)
+	// Check if user has required permission, honoring a column-specific rule if there is one
+	if !acl.CanAccessColumn(table, column, action) {
+		return fmt.Errorf("%w: user %s does not have %s permission for %s",
+			ErrAccessDenied, user.Email, action, table)
 	}
 
 	return nil
@@ -126,13 +131,14 @@ func (m *ACLMiddleware) FilterColumns(ctx context.Context, table string, columns
 		return nil, err
 	}
 
-	// Check table-level read access
-	if acl.CanAccess(table, "read") {
-		return columns, nil // User has read access to the table, return all columns
+	// Keep the columns the user may read, column rules taking precedence over table rules
+	allowed := []string{}
+	for _, column := range columns {
+		if acl.CanAccessColumn(table, column, "read") {
+			allowed = append(allowed, column)
+		}
 	}
-
-	// No table access, return empty slice
-	return []string{}, nil
+	return allowed, nil
 }
 
 // CheckPermissionFlexible validates permission using either ACL or scope check
@@ -156,8 +162,8 @@ func (m *ACLMiddleware) CheckPermissionFlexible(ctx context.Context, table, colu
 	}
 
 	// Both checks failed
-	return fmt.Errorf("access denied: user %s does not have %s permission for %s (checked both ACL and scope %s)",
-		user.Email, action, table, requiredScope)
+	return fmt.Errorf("%w: user %s does not have %s permission for %s (checked both ACL and scope %s)",
+		ErrAccessDenied, user.Email, action, table, requiredScope)
 }
 
 // CheckReadPermissionFlexible checks read permission with fallback to scope
@@ -176,19 +182,24 @@ func (m *ACLMiddleware) GetColumnPermissionsFlexible(ctx context.Context, table,
 	// Try ACL first
 	acl, err := m.service.GetMergedACL(ctx, user.Email)
 	if err == nil {
-		// ACL is available, check if user has access
-		if acl.CanAccess(table, "read") {
-			// User has table-level read access, allow all columns
-			columnAccess := make(map[string]string)
-			for _, column := range allColumns {
+		// ACL is available, decide each column by its most specific rule
+		columnAccess := make(map[string]string)
+		anyAllowed := false
+		for _, column := range allColumns {
+			if acl.CanAccessColumn(table, column, "read") {
 				columnAccess[column] = "allowed"
+				anyAllowed = true
+			} else {
+				columnAccess[column] = "blocked"
 			}
-			return NewColumnPermissions(table, columnAccess, false), nil // ACL used, not scope fallback
-		} else {
-			// User doesn't have table access - this includes blocking permissions
+		}
+
+		if !anyAllowed {
+			// User can't read any column - this includes blocking permissions
 			// Return error instead of blocked columns to completely deny access
-			return nil, fmt.Errorf("access denied: user %s does not have read permission for %s", user.Email, table)
+			return nil, fmt.Errorf("%w: user %s does not have read permission for %s", ErrAccessDenied, user.Email, table)
 		}
+		return NewColumnPermissions(table, columnAccess, false), nil // ACL used, not scope fallback
 	}
 
 	// ACL not available, check scope fallback
@@ -202,8 +213,8 @@ func (m *ACLMiddleware) GetColumnPermissionsFlexible(ctx context.Context, table,
 	}
 
 	// Both ACL and scope failed, deny access completely
-	return nil, fmt.Errorf("access denied: user %s does not have read permission for %s (checked both ACL and scope %s)",
-		user.Email, table, requiredScope)
+	return nil, fmt.Errorf("%w: user %s does not have read permission for %s (checked both ACL and scope %s)",
+		ErrAccessDenied, user.Email, table, requiredScope)
 }
 
 // CheckWritePermissionFlexible checks write permission with fallback to scope
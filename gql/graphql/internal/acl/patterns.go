@@ -0,0 +1,143 @@
+package acl
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+)
+
+// PatternKind is how a field filter value is matched
+type PatternKind int
+
+const (
+	PatternExact PatternKind = iota
+	PatternPrefix
+	PatternGlob
+	PatternRegex
+)
+
+const (
+	// regexPatternPrefix marks a value as a regular expression
+	regexPatternPrefix = "re:"
+	// maxPatternLength bounds the length of a field filter value
+	maxPatternLength = 256
+	// maxCachedPatterns bounds how many parsed patterns are kept
+	maxCachedPatterns = 4096
+)
+
+// ValuePattern is a parsed field filter value
+type ValuePattern struct {
+	Kind PatternKind
+	Text string // The exact value, or the prefix of a prefix pattern
+	re   *regexp.Regexp
+}
+
+// compiledPatterns caches parsed patterns, as the same filters are checked for every row. The
+// least recently used ones are evicted, so filters that are no longer stored do not pile up.
+var compiledPatterns = lru.New[*ValuePattern](maxCachedPatterns)
+
+// ParseValuePattern parses a field filter value, failing on malformed regular expressions.
+// Values in a field filter's include and exclude lists are patterns:
+//
+//	"MAV-NY-001"         the exact value
+//	"MAV-NY-*"           values starting with "MAV-NY-" (a single trailing *)
+//	"MAV-*-00?"          a glob: * matches any run of characters, ? exactly one
+//	"re:MAV-(NY|NJ)-\d+" a regular expression, anchored to match the whole value
+//
+// A literal * or ? can only be matched with a regular expression.
+func ParseValuePattern(value string) (*ValuePattern, error) {
+	if cached, ok := compiledPatterns.Get(context.Background(), value); ok {
+		return cached, nil
+	}
+
+	pattern, err := parseValuePattern(value)
+	if err != nil {
+		return nil, err
+	}
+	compiledPatterns.Add(context.Background(), value, pattern)
+	return pattern, nil
+}
+
+func parseValuePattern(value string) (*ValuePattern, error) {
+	if len(value) > maxPatternLength {
+		return nil, fmt.Errorf("pattern %.20q... is longer than %d characters", value, maxPatternLength)
+	}
+
+	if expression, ok := strings.CutPrefix(value, regexPatternPrefix); ok {
+		if expression == "" {
+			return nil, fmt.Errorf("pattern %q: empty regular expression", value)
+		}
+		re, err := regexp.Compile(`^(?:` + expression + `)$`)
+		if err != nil {
+			return nil, fmt.Errorf("pattern %q: %w", value, err)
+		}
+		return &ValuePattern{Kind: PatternRegex, Text: value, re: re}, nil
+	}
+
+	if !strings.ContainsAny(value, "*?") {
+		return &ValuePattern{Kind: PatternExact, Text: value}, nil
+	}
+
+	prefix := strings.TrimSuffix(value, "*")
+	if !strings.ContainsAny(prefix, "*?") {
+		if prefix == "" {
+			return nil, fmt.Errorf("pattern %q matches every value; leave the list empty instead", value)
+		}
+		return &ValuePattern{Kind: PatternPrefix, Text: prefix}, nil
+	}
+
+	var expression strings.Builder
+	expression.WriteString("^")
+	for _, r := range value {
+		switch r {
+		case '*':
+			expression.WriteString(".*")
+		case '?':
+			expression.WriteString(".")
+		default:
+			expression.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	expression.WriteString("$")
+	return &ValuePattern{Kind: PatternGlob, Text: value, re: regexp.MustCompile(expression.String())}, nil
+}
+
+// Matches reports whether value matches the pattern
+func (p *ValuePattern) Matches(value string) bool {
+	switch p.Kind {
+	case PatternExact:
+		return value == p.Text
+	case PatternPrefix:
+		return strings.HasPrefix(value, p.Text)
+	default:
+		return p.re.MatchString(value)
+	}
+}
+
+// Validate checks that every include and exclude value is a valid pattern
+func (ff *FieldFilter) Validate() error {
+	for _, list := range []struct {
+		name   string
+		values []string
+	}{{"includeList", ff.IncludeList}, {"excludeList", ff.ExcludeList}} {
+		for _, value := range list.values {
+			if _, err := ParseValuePattern(value); err != nil {
+				return fmt.Errorf("%s: %w", list.name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// matchesPattern matches value against a stored pattern. Patterns stored before validation
+// that fail to parse yield invalid, so callers can fail closed.
+func matchesPattern(pattern, value string, invalid bool) bool {
+	parsed, err := ParseValuePattern(pattern)
+	if err != nil {
+		return invalid
+	}
+	return parsed.Matches(value)
+}
@@ -2,7 +2,11 @@ package acl
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -10,46 +14,73 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 )
 
-// DynamoRepository handles DynamoDB operations for ACL records
+// DynamoRepository handles DynamoDB operations for ACL records, their audit log and their
+// versions
 type DynamoRepository struct {
-	client    *dynamodb.Client
-	tableName string
+	client           *dynamodb.Client
+	tableName        string
+	auditTableName   string // Keyed by PrincipalID and EntryID, and by Log and EntryID in auditLogIndexName
+	versionTableName string // Keyed by PrincipalID and Version (a number)
 }
 
+const (
+	// auditLogIndexName is the audit table GSI that lists every principal's entries in time
+	// order. It is keyed by auditLogAttribute and EntryID, and must project all attributes.
+	auditLogIndexName = "log-entry-index"
+	// auditLogAttribute holds auditLogPartition on every entry, so the index has one partition
+	auditLogAttribute = "Log"
+	auditLogPartition = "acl"
+)
+
 // NewDynamoRepository creates a new DynamoDB repository for ACL operations
-func NewDynamoRepository(client *dynamodb.Client, tableName string) *DynamoRepository {
+func NewDynamoRepository(client *dynamodb.Client, tableName, auditTableName, versionTableName string) *DynamoRepository {
 	return &DynamoRepository{
-		client:    client,
-		tableName: tableName,
+		client:           client,
+		tableName:        tableName,
+		auditTableName:   auditTableName,
+		versionTableName: versionTableName,
 	}
 }
 
 // GetUserRecord fetches a user's ACL record from DynamoDB
 func (r *DynamoRepository) GetUserRecord(ctx context.Context, email string) (*ACLRecord, error) {
+	record, found, err := r.getRecord(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		// User not found, return empty record
+		return &ACLRecord{
+			PrincipalID: email,
+			Groups:      []string{},
+			Permissions: make(map[string]string),
+			UpdatedAt:   time.Now().UTC().Format(time.RFC3339Nano),
+		}, nil
+	}
+
+	return record, nil
+}
+
+// getRecord fetches a user or group record, reporting whether it exists
+func (r *DynamoRepository) getRecord(ctx context.Context, principalID string) (*ACLRecord, bool, error) {
 	input := &dynamodb.GetItemInput{
 		TableName: aws.String(r.tableName),
 		Key: map[string]types.AttributeValue{
-			"PrincipalID": &types.AttributeValueMemberS{Value: email},
+			"PrincipalID": &types.AttributeValueMemberS{Value: principalID},
 		},
 	}
 
 	result, err := r.client.GetItem(ctx, input)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user record for %s: %w", email, err)
+		return nil, false, fmt.Errorf("failed to get user record for %s: %w", principalID, err)
 	}
 
 	if result.Item == nil {
-		// User not found, return empty record
-		return &ACLRecord{
-			PrincipalID: email,
-			Groups:      []string{},
-			Permissions: make(map[string]string),
-			UpdatedAt:   time.Now().UTC().Format(time.RFC3339),
-		}, nil
+		return nil, false, nil
 	}
 
-	record := r.unmarshalACLRecord(result.Item)
-	return record, nil
+	return r.unmarshalACLRecord(result.Item), true, nil
 }
 
 // BatchGetGroupRecords fetches multiple group records from DynamoDB
@@ -94,18 +125,25 @@ func (r *DynamoRepository) BatchGetGroupRecords(ctx context.Context, groupNames
 	return records, nil
 }
 
-// PutUserRecord creates or updates a user's ACL record
-func (r *DynamoRepository) PutUserRecord(ctx context.Context, record *ACLRecord) error {
-	record.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+// PutUserRecord creates or updates a user's ACL record, together with the audit entry
+// recording the change. It returns a ConflictError if the record is not in the precondition's
+// state (nil skips the check).
+func (r *DynamoRepository) PutUserRecord(ctx context.Context, record *ACLRecord, entry *AuditEntry, precondition *Precondition) error {
+	record.UpdatedAt = time.Now().UTC().Format(time.RFC3339Nano)
 
 	item := r.marshalACLRecord(record)
 
-	input := &dynamodb.PutItemInput{
-		TableName: aws.String(r.tableName),
-		Item:      item,
-	}
-
-	_, err := r.client.PutItem(ctx, input)
+	err := r.writeAudited(ctx, auditedWrite{
+		write: types.TransactWriteItem{
+			Put: &types.Put{
+				TableName: aws.String(r.tableName),
+				Item:      item,
+			},
+		},
+		record:       record,
+		entry:        entry,
+		precondition: precondition,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to put user record: %w", err)
 	}
@@ -113,40 +151,389 @@ func (r *DynamoRepository) PutUserRecord(ctx context.Context, record *ACLRecord)
 	return nil
 }
 
-// PutGroupRecord creates or updates a group's ACL record
-func (r *DynamoRepository) PutGroupRecord(ctx context.Context, record *ACLRecord) error {
-	record.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+// PutGroupRecord creates or updates a group's ACL record, together with the audit entry
+// recording the change. It returns a ConflictError if the record is not in the precondition's
+// state (nil skips the check).
+func (r *DynamoRepository) PutGroupRecord(ctx context.Context, record *ACLRecord, entry *AuditEntry, precondition *Precondition) error {
+	record.UpdatedAt = time.Now().UTC().Format(time.RFC3339Nano)
 
 	item := r.marshalACLRecord(record)
 
+	err := r.writeAudited(ctx, auditedWrite{
+		write: types.TransactWriteItem{
+			Put: &types.Put{
+				TableName: aws.String(r.tableName),
+				Item:      item,
+			},
+		},
+		record:       record,
+		entry:        entry,
+		precondition: precondition,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put group record: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteRecord deletes a user or group record, together with the audit entry recording the
+// deletion. It returns a ConflictError if the record is not in the precondition's state (nil
+// skips the check).
+func (r *DynamoRepository) DeleteRecord(ctx context.Context, principalID string, entry *AuditEntry, precondition *Precondition) error {
+	err := r.writeAudited(ctx, auditedWrite{
+		write: types.TransactWriteItem{
+			Delete: &types.Delete{
+				TableName: aws.String(r.tableName),
+				Key: map[string]types.AttributeValue{
+					"PrincipalID": &types.AttributeValueMemberS{Value: principalID},
+				},
+			},
+		},
+		entry:        entry,
+		precondition: precondition,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete record for %s: %w", principalID, err)
+	}
+
+	return nil
+}
+
+// RecordWrite is a record to create or update, with the audit entry recording the change and
+// the state the change is based on
+type RecordWrite struct {
+	Record       *ACLRecord
+	Entry        *AuditEntry
+	Precondition *Precondition
+}
+
+// MaxRecordsPerTransaction is the most records PutRecords writes at once: a transaction holds at
+// most 100 items, and each record takes three
+const MaxRecordsPerTransaction = 33
+
+// PutRecords creates or updates several records in one transaction, each with its audit entry
+// and version, so either all of them are written or none is. It returns a ConflictError for the
+// first record not in its precondition's state.
+func (r *DynamoRepository) PutRecords(ctx context.Context, records []RecordWrite) error {
+	if len(records) > MaxRecordsPerTransaction {
+		return fmt.Errorf("cannot write %d records in one transaction, at most %d", len(records), MaxRecordsPerTransaction)
+	}
+
+	updatedAt := time.Now().UTC().Format(time.RFC3339Nano)
+	writes := make([]auditedWrite, 0, len(records))
+	for _, record := range records {
+		record.Record.UpdatedAt = updatedAt
+		writes = append(writes, auditedWrite{
+			write: types.TransactWriteItem{
+				Put: &types.Put{
+					TableName: aws.String(r.tableName),
+					Item:      r.marshalACLRecord(record.Record),
+				},
+			},
+			record:       record.Record,
+			entry:        record.Entry,
+			precondition: record.Precondition,
+		})
+	}
+
+	if err := r.writeAudited(ctx, writes...); err != nil {
+		return fmt.Errorf("failed to put %d records: %w", len(records), err)
+	}
+
+	return nil
+}
+
+// auditedWrite is a write to the ACL table with the audit entry recording it and the record it
+// leaves behind (nil for a deletion)
+type auditedWrite struct {
+	write        types.TransactWriteItem
+	record       *ACLRecord
+	entry        *AuditEntry
+	precondition *Precondition
+}
+
+// writeAudited applies writes to the ACL table, appends their audit entries and stores the
+// records they leave behind as the entries' versions, all in one transaction, so no change is
+// made without a trace. Neither an entry nor a version may exist yet, which keeps both
+// append-only and stops concurrent changes from claiming the same version; either failing, or
+// a record not being in its precondition's state, is a ConflictError.
+func (r *DynamoRepository) writeAudited(ctx context.Context, writes ...auditedWrite) error {
+	var items []types.TransactWriteItem
+	for _, w := range writes {
+		write := w.write
+		if condition, names, values := preconditionExpression(w.precondition); condition != "" {
+			switch {
+			case write.Put != nil:
+				write.Put.ConditionExpression = aws.String(condition)
+				write.Put.ExpressionAttributeNames = names
+				write.Put.ExpressionAttributeValues = values
+			case write.Delete != nil:
+				write.Delete.ConditionExpression = aws.String(condition)
+				write.Delete.ExpressionAttributeNames = names
+				write.Delete.ExpressionAttributeValues = values
+			}
+		}
+
+		version := &ACLVersion{
+			PrincipalID: w.entry.PrincipalID,
+			Version:     w.entry.Version,
+			Record:      w.record,
+			Actor:       w.entry.Actor,
+			Operation:   w.entry.Operation,
+			CreatedAt:   w.entry.Timestamp,
+		}
+
+		// Keep these three items in order: a cancellation reason's index identifies its write
+		items = append(items,
+			write,
+			types.TransactWriteItem{
+				Put: &types.Put{
+					TableName:           aws.String(r.auditTableName),
+					Item:                marshalAuditEntry(w.entry),
+					ConditionExpression: aws.String("attribute_not_exists(EntryID)"),
+				},
+			},
+			types.TransactWriteItem{
+				Put: &types.Put{
+					TableName:           aws.String(r.versionTableName),
+					Item:                r.marshalACLVersion(version),
+					ConditionExpression: aws.String("attribute_not_exists(Version)"),
+				},
+			},
+		)
+	}
+
+	_, err := r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: items,
+	})
+	var canceled *types.TransactionCanceledException
+	if !errors.As(err, &canceled) {
+		return err
+	}
+	failed := slices.IndexFunc(canceled.CancellationReasons, func(reason types.CancellationReason) bool {
+		return aws.ToString(reason.Code) == "ConditionalCheckFailed"
+	})
+	if failed < 0 || failed/3 >= len(writes) {
+		return err
+	}
+
+	principalID := writes[failed/3].entry.PrincipalID
+	conflict := &ConflictError{PrincipalID: principalID}
+	current, found, getErr := r.getRecord(ctx, principalID)
+	if getErr != nil {
+		return fmt.Errorf("%w (and reading the current record failed: %v)", conflict, getErr)
+	}
+	if found {
+		conflict.Current = current
+	}
+	return conflict
+}
+
+// preconditionExpression builds the condition keeping a record in the precondition's state,
+// or "" if there is nothing to check. Records written before versioning have no version,
+// which counts as version 0.
+func preconditionExpression(precondition *Precondition) (string, map[string]string, map[string]types.AttributeValue) {
+	if precondition == nil {
+		return "", nil, nil
+	}
+	if precondition.Absent {
+		return "attribute_not_exists(#principal)", map[string]string{"#principal": "PrincipalID"}, nil
+	}
+
+	var conditions []string
+	names := map[string]string{}
+	values := map[string]types.AttributeValue{}
+	if precondition.Version != nil {
+		names["#version"] = "Version"
+		values[":version"] = &types.AttributeValueMemberN{Value: strconv.Itoa(*precondition.Version)}
+		if *precondition.Version == 0 {
+			conditions = append(conditions, "(attribute_not_exists(#version) OR #version = :version)")
+		} else {
+			conditions = append(conditions, "#version = :version")
+		}
+	}
+	if precondition.UpdatedAt != "" {
+		names["#updatedAt"] = "UpdatedAt"
+		values[":updatedAt"] = &types.AttributeValueMemberS{Value: precondition.UpdatedAt}
+		conditions = append(conditions, "#updatedAt = :updatedAt")
+	}
+	if len(conditions) == 0 {
+		return "", nil, nil
+	}
+
+	return strings.Join(conditions, " AND "), names, values
+}
+
+// LatestVersion returns the number of a principal's latest version, or 0 if it has none
+func (r *DynamoRepository) LatestVersion(ctx context.Context, principalID string) (int, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(r.versionTableName),
+		KeyConditionExpression: aws.String("PrincipalID = :principal"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":principal": &types.AttributeValueMemberS{Value: principalID},
+		},
+		ProjectionExpression: aws.String("Version"),
+		ScanIndexForward:     aws.Bool(false),
+		Limit:                aws.Int32(1),
+	}
+
+	result, err := r.client.Query(ctx, input)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get latest version of %s: %w", principalID, err)
+	}
+	if len(result.Items) == 0 {
+		return 0, nil
+	}
+
+	return numberAttribute(result.Items[0], "Version"), nil
+}
+
+// PutBaselineVersion stores a record as version 0 of its principal, unless it already has one
+func (r *DynamoRepository) PutBaselineVersion(ctx context.Context, record *ACLRecord) error {
 	input := &dynamodb.PutItemInput{
-		TableName: aws.String(r.tableName),
-		Item:      item,
+		TableName: aws.String(r.versionTableName),
+		Item: r.marshalACLVersion(&ACLVersion{
+			PrincipalID: record.PrincipalID,
+			Record:      record,
+			CreatedAt:   time.Now().UTC(),
+		}),
+		ConditionExpression: aws.String("attribute_not_exists(Version)"),
 	}
 
 	_, err := r.client.PutItem(ctx, input)
-	if err != nil {
-		return fmt.Errorf("failed to put group record: %w", err)
+	var conditionFailed *types.ConditionalCheckFailedException
+	if err != nil && !errors.As(err, &conditionFailed) {
+		return fmt.Errorf("failed to put baseline version of %s: %w", record.PrincipalID, err)
 	}
 
 	return nil
 }
 
-// DeleteRecord deletes a user or group record
-func (r *DynamoRepository) DeleteRecord(ctx context.Context, principalID string) error {
-	input := &dynamodb.DeleteItemInput{
-		TableName: aws.String(r.tableName),
+// GetVersion fetches one version of a principal's record, or nil if it does not exist
+func (r *DynamoRepository) GetVersion(ctx context.Context, principalID string, version int) (*ACLVersion, error) {
+	input := &dynamodb.GetItemInput{
+		TableName: aws.String(r.versionTableName),
 		Key: map[string]types.AttributeValue{
 			"PrincipalID": &types.AttributeValueMemberS{Value: principalID},
+			"Version":     &types.AttributeValueMemberN{Value: strconv.Itoa(version)},
 		},
 	}
 
-	_, err := r.client.DeleteItem(ctx, input)
+	result, err := r.client.GetItem(ctx, input)
 	if err != nil {
-		return fmt.Errorf("failed to delete record for %s: %w", principalID, err)
+		return nil, fmt.Errorf("failed to get version %d of %s: %w", version, principalID, err)
+	}
+	if result.Item == nil {
+		return nil, nil
 	}
 
-	return nil
+	return r.unmarshalACLVersion(result.Item), nil
+}
+
+// ListVersions lists every version of a principal's record, newest first
+func (r *DynamoRepository) ListVersions(ctx context.Context, principalID string) ([]*ACLVersion, error) {
+	paginator := dynamodb.NewQueryPaginator(r.client, &dynamodb.QueryInput{
+		TableName:              aws.String(r.versionTableName),
+		KeyConditionExpression: aws.String("PrincipalID = :principal"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":principal": &types.AttributeValueMemberS{Value: principalID},
+		},
+		ScanIndexForward: aws.Bool(false),
+	})
+
+	var versions []*ACLVersion
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list versions of %s: %w", principalID, err)
+		}
+		for _, item := range page.Items {
+			versions = append(versions, r.unmarshalACLVersion(item))
+		}
+	}
+
+	return versions, nil
+}
+
+// ListAuditEntries lists the audit entries matching the filter, newest first. Entries of one
+// principal are queried by key, others through auditLogIndexName; reading stops once Limit
+// entries match.
+func (r *DynamoRepository) ListAuditEntries(ctx context.Context, filter AuditFilter) ([]*AuditEntry, error) {
+	input := &dynamodb.QueryInput{
+		TableName:        aws.String(r.auditTableName),
+		ScanIndexForward: aws.Bool(false),
+	}
+	names := map[string]string{"#entry": "EntryID"}
+	values := map[string]types.AttributeValue{}
+	if filter.PrincipalID != "" {
+		names["#partition"] = "PrincipalID"
+		values[":partition"] = &types.AttributeValueMemberS{Value: filter.PrincipalID}
+	} else {
+		input.IndexName = aws.String(auditLogIndexName)
+		names["#partition"] = auditLogAttribute
+		values[":partition"] = &types.AttributeValueMemberS{Value: auditLogPartition}
+	}
+	keyConditions := []string{"#partition = :partition"}
+
+	// Entry IDs start with their timestamp followed by "#", so comparing them with a bare
+	// timestamp bounds entries by time: an entry made at Until sorts after it
+	if !filter.From.IsZero() {
+		values[":from"] = &types.AttributeValueMemberS{Value: filter.From.UTC().Format(auditTimeLayout)}
+	}
+	if !filter.Until.IsZero() {
+		values[":until"] = &types.AttributeValueMemberS{Value: filter.Until.UTC().Format(auditTimeLayout)}
+	}
+	switch {
+	case !filter.From.IsZero() && !filter.Until.IsZero():
+		keyConditions = append(keyConditions, "#entry BETWEEN :from AND :until")
+	case !filter.From.IsZero():
+		keyConditions = append(keyConditions, "#entry >= :from")
+	case !filter.Until.IsZero():
+		keyConditions = append(keyConditions, "#entry < :until")
+	default:
+		delete(names, "#entry")
+	}
+	input.KeyConditionExpression = aws.String(strings.Join(keyConditions, " AND "))
+
+	var filterConditions []string
+	if filter.Actor != "" {
+		names["#actor"] = "Actor"
+		values[":actor"] = &types.AttributeValueMemberS{Value: filter.Actor}
+		filterConditions = append(filterConditions, "#actor = :actor")
+	}
+	if filter.Operation != "" {
+		names["#operation"] = "Operation"
+		values[":operation"] = &types.AttributeValueMemberS{Value: string(filter.Operation)}
+		filterConditions = append(filterConditions, "#operation = :operation")
+	}
+	if len(filterConditions) > 0 {
+		input.FilterExpression = aws.String(strings.Join(filterConditions, " AND "))
+	}
+	input.ExpressionAttributeNames = names
+	input.ExpressionAttributeValues = values
+
+	var entries []*AuditEntry
+	for {
+		// Limit caps the entries read, not the ones matching, so later pages ask for the rest
+		if filter.Limit > 0 {
+			input.Limit = aws.Int32(int32(filter.Limit - len(entries)))
+		}
+		page, err := r.client.Query(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query audit entries: %w", err)
+		}
+		for _, item := range page.Items {
+			entries = append(entries, unmarshalAuditEntry(item))
+		}
+
+		if page.LastEvaluatedKey == nil || (filter.Limit > 0 && len(entries) >= filter.Limit) {
+			break
+		}
+		input.ExclusiveStartKey = page.LastEvaluatedKey
+	}
+
+	return entries, nil
 }
 
 // ListRecords lists all ACL records (for administrative purposes)
@@ -155,15 +542,17 @@ func (r *DynamoRepository) ListRecords(ctx context.Context) ([]*ACLRecord, error
 		TableName: aws.String(r.tableName),
 	}
 
-	result, err := r.client.Scan(ctx, input)
-	if err != nil {
-		return nil, fmt.Errorf("failed to scan ACL records: %w", err)
-	}
-
 	var records []*ACLRecord
-	for _, item := range result.Items {
-		record := r.unmarshalACLRecord(item)
-		records = append(records, record)
+	paginator := dynamodb.NewScanPaginator(r.client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ACL records: %w", err)
+		}
+		for _, item := range page.Items {
+			record := r.unmarshalACLRecord(item)
+			records = append(records, record)
+		}
 	}
 
 	return records, nil
@@ -174,6 +563,7 @@ func (r *DynamoRepository) marshalACLRecord(record *ACLRecord) map[string]types.
 	item := map[string]types.AttributeValue{
 		"PrincipalID": &types.AttributeValueMemberS{Value: record.PrincipalID},
 		"UpdatedAt":   &types.AttributeValueMemberS{Value: record.UpdatedAt},
+		"Version":     &types.AttributeValueMemberN{Value: strconv.Itoa(record.Version)},
 	}
 
 	// Marshal Groups (list of strings)
@@ -219,6 +609,9 @@ func (r *DynamoRepository) marshalACLRecord(record *ACLRecord) map[string]types.
 				filterMap["IncludeList"] = &types.AttributeValueMemberL{Value: []types.AttributeValue{}}
 			}
 
+			// Marshal validity window, if any
+			marshalValidity(filterMap, filter.Validity)
+
 			// Marshal ExcludeList
 			if len(filter.ExcludeList) > 0 {
 				excludeItems := make([]types.AttributeValue, 0, len(filter.ExcludeList))
@@ -237,6 +630,32 @@ func (r *DynamoRepository) marshalACLRecord(record *ACLRecord) map[string]types.
 		item["FieldFilters"] = &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{}}
 	}
 
+	// Marshal PermissionValidity and GroupValidity (maps of key to validity window)
+	for name, validities := range map[string]map[string]Validity{
+		"PermissionValidity": record.PermissionValidity,
+		"GroupValidity":      record.GroupValidity,
+	} {
+		if len(validities) == 0 {
+			continue
+		}
+		validityItems := make(map[string]types.AttributeValue)
+		for key, validity := range validities {
+			validityMap := make(map[string]types.AttributeValue)
+			marshalValidity(validityMap, validity)
+			validityItems[key] = &types.AttributeValueMemberM{Value: validityMap}
+		}
+		item[name] = &types.AttributeValueMemberM{Value: validityItems}
+	}
+
+	// Marshal MergeStrategies (map of string to string), only set on group records
+	if len(record.MergeStrategies) > 0 {
+		strategyItems := make(map[string]types.AttributeValue)
+		for key, strategy := range record.MergeStrategies {
+			strategyItems[key] = &types.AttributeValueMemberS{Value: string(strategy)}
+		}
+		item["MergeStrategies"] = &types.AttributeValueMemberM{Value: strategyItems}
+	}
+
 	return item
 }
 
@@ -262,6 +681,9 @@ func (r *DynamoRepository) unmarshalACLRecord(item map[string]types.AttributeVal
 		}
 	}
 
+	// Unmarshal Version
+	record.Version = numberAttribute(item, "Version")
+
 	// Unmarshal Groups
 	if val, ok := item["Groups"]; ok {
 		if l, ok := val.(*types.AttributeValueMemberL); ok {
@@ -316,6 +738,9 @@ func (r *DynamoRepository) unmarshalACLRecord(item map[string]types.AttributeVal
 						}
 					}
 
+					// Unmarshal validity window
+					filter.Validity = unmarshalValidity(filterMap.Value)
+
 					// Unmarshal ExcludeList
 					if excludeListVal, exists := filterMap.Value["ExcludeList"]; exists {
 						if l, ok := excludeListVal.(*types.AttributeValueMemberL); ok {
@@ -333,5 +758,175 @@ func (r *DynamoRepository) unmarshalACLRecord(item map[string]types.AttributeVal
 		}
 	}
 
+	// Unmarshal PermissionValidity and GroupValidity
+	for name, target := range map[string]*map[string]Validity{
+		"PermissionValidity": &record.PermissionValidity,
+		"GroupValidity":      &record.GroupValidity,
+	} {
+		if val, ok := item[name]; ok {
+			if m, ok := val.(*types.AttributeValueMemberM); ok {
+				*target = make(map[string]Validity, len(m.Value))
+				for key, validityVal := range m.Value {
+					if validityMap, ok := validityVal.(*types.AttributeValueMemberM); ok {
+						(*target)[key] = unmarshalValidity(validityMap.Value)
+					}
+				}
+			}
+		}
+	}
+
+	// Unmarshal MergeStrategies
+	if val, ok := item["MergeStrategies"]; ok {
+		if m, ok := val.(*types.AttributeValueMemberM); ok {
+			record.MergeStrategies = make(map[string]FieldFilterMergeStrategy, len(m.Value))
+			for key, strategyVal := range m.Value {
+				if s, ok := strategyVal.(*types.AttributeValueMemberS); ok {
+					record.MergeStrategies[key] = FieldFilterMergeStrategy(s.Value)
+				}
+			}
+		}
+	}
+
 	return record
 }
+
+// marshalValidity adds the set bounds of a validity window to an item map, as RFC 3339 strings
+func marshalValidity(item map[string]types.AttributeValue, validity Validity) {
+	if !validity.ValidFrom.IsZero() {
+		item["ValidFrom"] = &types.AttributeValueMemberS{Value: validity.ValidFrom.UTC().Format(time.RFC3339)}
+	}
+	if !validity.ValidUntil.IsZero() {
+		item["ValidUntil"] = &types.AttributeValueMemberS{Value: validity.ValidUntil.UTC().Format(time.RFC3339)}
+	}
+}
+
+// unmarshalValidity reads a validity window from an item map. Unreadable bounds fail closed:
+// the grant never starts, or has already ended, and the window records why.
+func unmarshalValidity(item map[string]types.AttributeValue) Validity {
+	var validity Validity
+	if val, ok := item["ValidFrom"].(*types.AttributeValueMemberS); ok {
+		if parsed, err := time.Parse(time.RFC3339, val.Value); err == nil {
+			validity.ValidFrom = parsed
+		} else {
+			validity.ValidFrom = time.Unix(1<<62, 0)
+			validity.parseErr = fmt.Errorf("ValidFrom: %w", err)
+		}
+	}
+	if val, ok := item["ValidUntil"].(*types.AttributeValueMemberS); ok {
+		if parsed, err := time.Parse(time.RFC3339, val.Value); err == nil {
+			validity.ValidUntil = parsed
+		} else {
+			validity.ValidUntil = time.Unix(1, 0)
+			validity.parseErr = fmt.Errorf("ValidUntil: %w", err)
+		}
+	}
+	return validity
+}
+
+// marshalAuditEntry converts an AuditEntry to a DynamoDB item
+func marshalAuditEntry(entry *AuditEntry) map[string]types.AttributeValue {
+	changeItems := make([]types.AttributeValue, 0, len(entry.Changes))
+	for _, change := range entry.Changes {
+		changeMap := map[string]types.AttributeValue{
+			"Path": &types.AttributeValueMemberS{Value: change.Path},
+		}
+		if change.Before != "" {
+			changeMap["Before"] = &types.AttributeValueMemberS{Value: change.Before}
+		}
+		if change.After != "" {
+			changeMap["After"] = &types.AttributeValueMemberS{Value: change.After}
+		}
+		changeItems = append(changeItems, &types.AttributeValueMemberM{Value: changeMap})
+	}
+
+	return map[string]types.AttributeValue{
+		"PrincipalID":     &types.AttributeValueMemberS{Value: entry.PrincipalID},
+		"EntryID":         &types.AttributeValueMemberS{Value: entry.EntryID},
+		auditLogAttribute: &types.AttributeValueMemberS{Value: auditLogPartition},
+		"Actor":           &types.AttributeValueMemberS{Value: entry.Actor},
+		"Timestamp":       &types.AttributeValueMemberS{Value: entry.Timestamp.UTC().Format(time.RFC3339Nano)},
+		"Operation":       &types.AttributeValueMemberS{Value: string(entry.Operation)},
+		"Version":         &types.AttributeValueMemberN{Value: strconv.Itoa(entry.Version)},
+		"Changes":         &types.AttributeValueMemberL{Value: changeItems},
+	}
+}
+
+// unmarshalAuditEntry converts a DynamoDB item to an AuditEntry
+func unmarshalAuditEntry(item map[string]types.AttributeValue) *AuditEntry {
+	entry := &AuditEntry{
+		PrincipalID: stringAttribute(item, "PrincipalID"),
+		EntryID:     stringAttribute(item, "EntryID"),
+		Actor:       stringAttribute(item, "Actor"),
+		Operation:   AuditOperation(stringAttribute(item, "Operation")),
+		Version:     numberAttribute(item, "Version"),
+	}
+	entry.Timestamp, _ = time.Parse(time.RFC3339Nano, stringAttribute(item, "Timestamp"))
+
+	if l, ok := item["Changes"].(*types.AttributeValueMemberL); ok {
+		for _, changeVal := range l.Value {
+			if changeMap, ok := changeVal.(*types.AttributeValueMemberM); ok {
+				entry.Changes = append(entry.Changes, AuditChange{
+					Path:   stringAttribute(changeMap.Value, "Path"),
+					Before: stringAttribute(changeMap.Value, "Before"),
+					After:  stringAttribute(changeMap.Value, "After"),
+				})
+			}
+		}
+	}
+
+	return entry
+}
+
+// marshalACLVersion converts an ACLVersion to a DynamoDB item
+func (r *DynamoRepository) marshalACLVersion(version *ACLVersion) map[string]types.AttributeValue {
+	item := map[string]types.AttributeValue{
+		"PrincipalID": &types.AttributeValueMemberS{Value: version.PrincipalID},
+		"Version":     &types.AttributeValueMemberN{Value: strconv.Itoa(version.Version)},
+		"CreatedAt":   &types.AttributeValueMemberS{Value: version.CreatedAt.UTC().Format(time.RFC3339Nano)},
+	}
+	if version.Actor != "" {
+		item["Actor"] = &types.AttributeValueMemberS{Value: version.Actor}
+	}
+	if version.Operation != "" {
+		item["Operation"] = &types.AttributeValueMemberS{Value: string(version.Operation)}
+	}
+	if version.Record != nil {
+		item["Record"] = &types.AttributeValueMemberM{Value: r.marshalACLRecord(version.Record)}
+	}
+	return item
+}
+
+// unmarshalACLVersion converts a DynamoDB item to an ACLVersion
+func (r *DynamoRepository) unmarshalACLVersion(item map[string]types.AttributeValue) *ACLVersion {
+	version := &ACLVersion{
+		PrincipalID: stringAttribute(item, "PrincipalID"),
+		Version:     numberAttribute(item, "Version"),
+		Actor:       stringAttribute(item, "Actor"),
+		Operation:   AuditOperation(stringAttribute(item, "Operation")),
+	}
+	version.CreatedAt, _ = time.Parse(time.RFC3339Nano, stringAttribute(item, "CreatedAt"))
+
+	if m, ok := item["Record"].(*types.AttributeValueMemberM); ok {
+		version.Record = r.unmarshalACLRecord(m.Value)
+	}
+
+	return version
+}
+
+// stringAttribute returns a string attribute of an item, or "" if it is missing
+func stringAttribute(item map[string]types.AttributeValue, name string) string {
+	if s, ok := item[name].(*types.AttributeValueMemberS); ok {
+		return s.Value
+	}
+	return ""
+}
+
+// numberAttribute returns an integer attribute of an item, or 0 if it is missing
+func numberAttribute(item map[string]types.AttributeValue, name string) int {
+	if n, ok := item[name].(*types.AttributeValueMemberN); ok {
+		if value, err := strconv.Atoi(n.Value); err == nil {
+			return value
+		}
+	}
+	return 0
+}
@@ -0,0 +1,130 @@
+package acl
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"ssot/gql/graphql/internal/dynamotest"
+)
+
+func TestPutUserRecordWritesConditionally(t *testing.T) {
+	server := dynamotest.NewServer(t)
+	server.Handle("TransactWriteItems", func(input map[string]any) (any, error) {
+		return map[string]any{}, nil
+	})
+	repo := NewDynamoRepository(server.Client(), "acl", "acl-audit", "acl-versions")
+
+	record := &ACLRecord{PrincipalID: "user@example.com", Version: 4, Permissions: map[string]string{"LoanInfo#*": "read"}}
+	entry := &AuditEntry{PrincipalID: record.PrincipalID, EntryID: "entry", Version: 4, Timestamp: time.Now()}
+	expected := 3
+	precondition := &Precondition{Version: &expected, UpdatedAt: "2024-05-01T10:00:00.123456789Z"}
+
+	if err := repo.PutUserRecord(t.Context(), record, entry, precondition); err != nil {
+		t.Fatalf("PutUserRecord: %v", err)
+	}
+	if _, err := time.Parse(time.RFC3339Nano, record.UpdatedAt); err != nil {
+		t.Errorf("UpdatedAt = %q, want an RFC 3339 timestamp: %v", record.UpdatedAt, err)
+	}
+
+	requests := server.Requests("TransactWriteItems")
+	if len(requests) != 1 {
+		t.Fatalf("ran %d transactions, want 1", len(requests))
+	}
+	items, _ := requests[0]["TransactItems"].([]any)
+	if len(items) != 3 {
+		t.Fatalf("transaction has %d items, want the record, its audit entry and its version", len(items))
+	}
+
+	tests := []struct {
+		table     string
+		condition string
+	}{
+		{table: "acl", condition: "#version = :version AND #updatedAt = :updatedAt"},
+		{table: "acl-audit", condition: "attribute_not_exists(EntryID)"},
+		{table: "acl-versions", condition: "attribute_not_exists(Version)"},
+	}
+	for i, tt := range tests {
+		put, _ := items[i].(map[string]any)["Put"].(map[string]any)
+		if put["TableName"] != tt.table || put["ConditionExpression"] != tt.condition {
+			t.Errorf("item %d puts to %v if %v, want %s if %s", i, put["TableName"], put["ConditionExpression"], tt.table, tt.condition)
+		}
+	}
+
+	put := items[0].(map[string]any)["Put"].(map[string]any)
+	values, _ := put["ExpressionAttributeValues"].(map[string]any)
+	if version, _ := values[":version"].(map[string]any); version["N"] != "3" {
+		t.Errorf(":version = %v, want 3", values[":version"])
+	}
+	if updatedAt, _ := values[":updatedAt"].(map[string]any); updatedAt["S"] != precondition.UpdatedAt {
+		t.Errorf(":updatedAt = %v, want %s", values[":updatedAt"], precondition.UpdatedAt)
+	}
+	item, _ := put["Item"].(map[string]any)
+	if written, _ := item["UpdatedAt"].(map[string]any); written["S"] != record.UpdatedAt {
+		t.Errorf("wrote UpdatedAt %v, want the record's %s", item["UpdatedAt"], record.UpdatedAt)
+	}
+}
+
+func TestPutUserRecordConflict(t *testing.T) {
+	tests := []struct {
+		name        string
+		failedItem  int
+		current     dynamotest.Item
+		wantVersion int
+	}{
+		{
+			name:       "record changed",
+			failedItem: 0,
+			current: dynamotest.Item{
+				"PrincipalID": dynamotest.S("user@example.com"),
+				"Version":     dynamotest.N("5"),
+				"UpdatedAt":   dynamotest.S("2024-05-02T08:00:00.5Z"),
+			},
+			wantVersion: 5,
+		},
+		{name: "record deleted", failedItem: 0, current: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reasons := []any{map[string]any{"Code": "None"}, map[string]any{"Code": "None"}, map[string]any{"Code": "None"}}
+			reasons[tt.failedItem] = map[string]any{"Code": "ConditionalCheckFailed"}
+
+			server := dynamotest.NewServer(t)
+			server.Handle("TransactWriteItems", func(input map[string]any) (any, error) {
+				return nil, &dynamotest.Error{
+					Type:    "TransactionCanceledException",
+					Message: "Transaction cancelled",
+					Fields:  map[string]any{"CancellationReasons": reasons},
+				}
+			})
+			server.Handle("GetItem", func(input map[string]any) (any, error) {
+				if tt.current == nil {
+					return map[string]any{}, nil
+				}
+				return map[string]any{"Item": tt.current}, nil
+			})
+			repo := NewDynamoRepository(server.Client(), "acl", "acl-audit", "acl-versions")
+
+			expected := 4
+			record := &ACLRecord{PrincipalID: "user@example.com", Version: 5}
+			entry := &AuditEntry{PrincipalID: record.PrincipalID, EntryID: "entry", Version: 5, Timestamp: time.Now()}
+			err := repo.PutUserRecord(t.Context(), record, entry, &Precondition{Version: &expected})
+
+			var conflict *ConflictError
+			if !errors.As(err, &conflict) {
+				t.Fatalf("PutUserRecord error = %v, want a ConflictError", err)
+			}
+			if conflict.PrincipalID != record.PrincipalID {
+				t.Errorf("conflict on %s, want %s", conflict.PrincipalID, record.PrincipalID)
+			}
+			if tt.current == nil {
+				if conflict.Current != nil {
+					t.Errorf("Current = %+v, want nil for a record that no longer exists", conflict.Current)
+				}
+			} else if conflict.Current == nil || conflict.Current.Version != tt.wantVersion {
+				t.Errorf("Current = %+v, want version %d", conflict.Current, tt.wantVersion)
+			}
+		})
+	}
+}
@@ -1,12 +1,21 @@
 package acl
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 )
 
+// maxGroupDepth bounds how many levels of groups a user's permissions are inherited through,
+// counting the user's own groups as the first level
+const maxGroupDepth = 5
+
 // ACLService provides access control functionality with caching
 type ACLService struct {
 	repo  *DynamoRepository
@@ -40,7 +49,8 @@ func (s *ACLService) GetMergedACL(ctx context.Context, email string) (*MergedACL
 			Permissions:  entry.ACL.Permissions,
 			FieldFilters: entry.ACL.FieldFilters,
 			Groups:       entry.ACL.Groups,
-			CachedAt:     time.Now().Add(-s.ttl).Add(time.Until(entry.ExpiresAt)),
+			CachedAt:     entry.CachedAt,
+			NextChange:   entry.NextChange,
 		}, nil
 	}
 	s.mutex.RUnlock()
@@ -58,59 +68,190 @@ func (s *ACLService) GetMergedACL(ctx context.Context, email string) (*MergedACL
 		Groups:       mergedACL.Groups,
 		Permissions:  mergedACL.Permissions,
 		FieldFilters: mergedACL.FieldFilters,
-		UpdatedAt:    time.Now().UTC().Format(time.RFC3339),
-	}, s.ttl)
+		UpdatedAt:    time.Now().UTC().Format(time.RFC3339Nano),
+	}, s.ttl, mergedACL.NextChange)
 	s.mutex.Unlock()
 
 	return mergedACL, nil
 }
 
-// fetchAndMergeACL fetches user and group data from DynamoDB and merges permissions
+// fetchAndMergeACL fetches user and group data from DynamoDB and merges the permissions,
+// group memberships and field filters in force now
 func (s *ACLService) fetchAndMergeACL(ctx context.Context, email string) (*MergedACL, error) {
+	now := time.Now()
+
 	// Step 1: Get user record
 	userRecord, err := s.repo.GetUserRecord(ctx, email)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user record: %w", err)
 	}
+	nextChange := userRecord.NextBoundary(now)
+	userRecord = userRecord.ActiveAt(now)
 
-	// Step 2: Get group records in batch
-	groupRecords, err := s.repo.BatchGetGroupRecords(ctx, userRecord.Groups)
+	// Step 2: Get the user's group records and the groups they inherit from
+	groupLevels, groupsChange, err := s.resolveGroups(ctx, userRecord.Groups, now)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get group records: %w", err)
 	}
-	
+	if !groupsChange.IsZero() && (nextChange.IsZero() || groupsChange.Before(nextChange)) {
+		nextChange = groupsChange
+	}
+
 	// Step 3: Merge permissions (user permissions take precedence)
-	mergedPermissions := make(map[string]string)
+	mergedPermissions := mergePermissions(userRecord.Permissions, groupLevels)
+
+	// Step 4: Merge field filters by the groups' merge strategies
+	mergedFieldFilters := MergeFieldFilters(userRecord.FieldFilters, groupLevels)
 
-	// First, add group permissions
-	for _, groupRecord := range groupRecords {
-		for key, value := range groupRecord.Permissions {
-			mergedPermissions[key] = value
+	return &MergedACL{
+		UserEmail:    email,
+		Permissions:  mergedPermissions,
+		FieldFilters: mergedFieldFilters,
+		Groups:       userRecord.Groups,
+		CachedAt:     now,
+		NextChange:   nextChange,
+	}, nil
+}
+
+// mergePermissions combines a user's permissions with those of their groups, given level by
+// level, nearest first. The user's own permissions override the groups', and the nearest level
+// setting a key decides it. Groups of one level that disagree on a key are resolved by
+// permissionPrecedence, so the most restrictive of them wins whatever order they were read in.
+func mergePermissions(userPermissions map[string]string, groupLevels [][]*ACLRecord) map[string]string {
+	merged := make(map[string]string)
+	for _, level := range groupLevels {
+		levelPermissions := make(map[string]string)
+		for _, groupRecord := range level {
+			for key, value := range groupRecord.Permissions {
+				if _, hidden := merged[key]; hidden {
+					continue
+				}
+				if current, exists := levelPermissions[key]; !exists || permissionPrecedence(value) > permissionPrecedence(current) {
+					levelPermissions[key] = value
+				}
+			}
 		}
+		maps.Copy(merged, levelPermissions)
 	}
 
-	// Then, add user permissions (overrides group permissions)
-	for key, value := range userRecord.Permissions {
-		mergedPermissions[key] = value
+	maps.Copy(merged, userPermissions)
+	return merged
+}
+
+// permissionPrecedence ranks permissions for resolving groups of one level that disagree:
+// blocking, then read, then write. Values hasPermission doesn't know grant nothing, so they
+// rank with blocking.
+func permissionPrecedence(permission string) int {
+	switch PermissionAction(permission) {
+	case ActionWrite, ActionReadWrite:
+		return 1
+	case ActionRead:
+		return 2
+	default:
+		return 3
 	}
+}
+
+// resolveGroups fetches the given group records and, level by level, the groups they inherit
+// from, keeping only the grants in force at now. Levels are ordered nearest first, each by
+// PrincipalID, which is the precedence the merge applies. A group reached more than once
+// keeps its nearest position, so cycles stored before they were rejected end there.
+// Inheritance deeper than maxGroupDepth is an error, as dropping the farther groups would
+// drop their restrictions. It also returns when a grant of the fetched groups next starts or
+// ends.
+func (s *ACLService) resolveGroups(ctx context.Context, groups []string, now time.Time) ([][]*ACLRecord, time.Time, error) {
+	var resolved [][]*ACLRecord
+	var nextChange time.Time
+	seen := make(map[string]bool)
+
+	level := groups
+	for depth := 1; len(level) > 0; depth++ {
+		var names []string
+		for _, name := range level {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			break
+		}
+		if depth > maxGroupDepth {
+			return nil, time.Time{}, fmt.Errorf("group inheritance is deeper than %d levels, reaching %s", maxGroupDepth, strings.Join(names, ", "))
+		}
 
-	// Step 4: Merge field filters (user filters take precedence)
-	groupFieldFilters := make(map[string]FieldFilter)
-	for _, groupRecord := range groupRecords {
-		for field, filter := range groupRecord.FieldFilters {
-			groupFieldFilters[field] = filter
+		records, err := s.repo.BatchGetGroupRecords(ctx, names)
+		if err != nil {
+			return nil, time.Time{}, err
 		}
+		slices.SortFunc(records, func(a, b *ACLRecord) int {
+			return strings.Compare(a.PrincipalID, b.PrincipalID)
+		})
+
+		level = nil
+		active := make([]*ACLRecord, len(records))
+		for i, record := range records {
+			if boundary := record.NextBoundary(now); !boundary.IsZero() && (nextChange.IsZero() || boundary.Before(nextChange)) {
+				nextChange = boundary
+			}
+			active[i] = record.ActiveAt(now)
+			level = append(level, active[i].Groups...)
+		}
+		resolved = append(resolved, active)
 	}
 
-	mergedFieldFilters := MergeFieldFilters(userRecord.FieldFilters, groupFieldFilters)
+	return resolved, nextChange, nil
+}
 
-	return &MergedACL{
-		UserEmail:    email,
-		Permissions:  mergedPermissions,
-		FieldFilters: mergedFieldFilters,
-		Groups:       userRecord.Groups,
-		CachedAt:     time.Now(),
-	}, nil
+// ValidateGroupParents checks that a group can inherit from parents: each parent must exist,
+// the group must not end up inheriting from itself, and the group and the groups it inherits
+// from must span at most maxGroupDepth levels
+func (s *ACLService) ValidateGroupParents(ctx context.Context, groupName string, parents []string) error {
+	return validateGroupParents(groupName, parents, func(groups []string) ([]*ACLRecord, error) {
+		return s.repo.BatchGetGroupRecords(ctx, groups)
+	})
+}
+
+// validateGroupParents is ValidateGroupParents reading groups with getGroups, which returns the
+// records of those that exist
+func validateGroupParents(groupName string, parents []string, getGroups func([]string) ([]*ACLRecord, error)) error {
+	if !isGroupName(groupName) {
+		groupName = "group:" + groupName
+	}
+
+	level := parents
+	for depth := 1; len(level) > 0; depth++ {
+		// The group itself is the first level, so its parents are the second
+		if depth+1 > maxGroupDepth {
+			return fmt.Errorf("group inheritance of %s is deeper than %d levels", groupName, maxGroupDepth)
+		}
+		if slices.Contains(level, groupName) {
+			return fmt.Errorf("group %s would inherit from itself", groupName)
+		}
+
+		records, err := getGroups(level)
+		if err != nil {
+			return err
+		}
+		if depth == 1 {
+			for _, parent := range parents {
+				if !slices.ContainsFunc(records, func(record *ACLRecord) bool { return record.PrincipalID == parent }) {
+					return fmt.Errorf("group %s does not exist", parent)
+				}
+			}
+		}
+
+		level = nil
+		for _, record := range records {
+			for _, group := range record.Groups {
+				if !slices.Contains(level, group) {
+					level = append(level, group)
+				}
+			}
+		}
+	}
+
+	return nil
 }
 
 // InvalidateCache removes a user's ACL from cache
@@ -162,10 +303,18 @@ func (s *ACLService) CreateUser(ctx context.Context, email string, groups []stri
 		Groups:       groups,
 		Permissions:  permissions,
 		FieldFilters: make(map[string]FieldFilter), // Initialize empty field filters
-		UpdatedAt:    time.Now().UTC().Format(time.RFC3339),
+		UpdatedAt:    time.Now().UTC().Format(time.RFC3339Nano),
 	}
 
-	err := s.repo.PutUserRecord(ctx, record)
+	before, precondition, err := s.readRecord(ctx, email, nil)
+	if err != nil {
+		return err
+	}
+	entry, err := s.auditEntry(ctx, AuditCreateUser, before, precondition, record)
+	if err != nil {
+		return err
+	}
+	err = s.repo.PutUserRecord(ctx, record, entry, precondition)
 	if err != nil {
 		return err
 	}
@@ -175,28 +324,40 @@ func (s *ACLService) CreateUser(ctx context.Context, email string, groups []stri
 	return nil
 }
 
-// CreateUserWithFieldFilters creates a new user ACL record with field filters
-func (s *ACLService) CreateUserWithFieldFilters(ctx context.Context, email string, groups []string, permissions map[string]string, fieldFilters map[string]FieldFilter) error {
+// CreateUserWithFieldFilters creates a new user ACL record with field filters. Permissions and
+// group memberships with an entry in permissionValidity or groupValidity are time-bound.
+// It returns the record as written.
+func (s *ACLService) CreateUserWithFieldFilters(ctx context.Context, email string, groups []string, permissions map[string]string, fieldFilters map[string]FieldFilter, permissionValidity, groupValidity map[string]Validity) (*ACLRecord, error) {
 	if fieldFilters == nil {
 		fieldFilters = make(map[string]FieldFilter)
 	}
 
 	record := &ACLRecord{
-		PrincipalID:  email,
-		Groups:       groups,
-		Permissions:  permissions,
-		FieldFilters: fieldFilters,
-		UpdatedAt:    time.Now().UTC().Format(time.RFC3339),
+		PrincipalID:        email,
+		Groups:             groups,
+		Permissions:        permissions,
+		FieldFilters:       fieldFilters,
+		PermissionValidity: permissionValidity,
+		GroupValidity:      groupValidity,
+		UpdatedAt:          time.Now().UTC().Format(time.RFC3339Nano),
 	}
 
-	err := s.repo.PutUserRecord(ctx, record)
+	before, precondition, err := s.readRecord(ctx, email, nil)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	entry, err := s.auditEntry(ctx, AuditCreateUser, before, precondition, record)
+	if err != nil {
+		return nil, err
+	}
+	err = s.repo.PutUserRecord(ctx, record, entry, precondition)
+	if err != nil {
+		return nil, err
 	}
 
 	// Invalidate cache for this user
 	s.InvalidateCache(email)
-	return nil
+	return record, nil
 }
 
 // CreateGroup creates a new group ACL record
@@ -210,10 +371,18 @@ func (s *ACLService) CreateGroup(ctx context.Context, groupName string, permissi
 		Groups:       []string{}, // Groups don't have group memberships
 		Permissions:  permissions,
 		FieldFilters: make(map[string]FieldFilter), // Initialize empty field filters
-		UpdatedAt:    time.Now().UTC().Format(time.RFC3339),
+		UpdatedAt:    time.Now().UTC().Format(time.RFC3339Nano),
 	}
 
-	err := s.repo.PutGroupRecord(ctx, record)
+	before, precondition, err := s.readRecord(ctx, groupName, nil)
+	if err != nil {
+		return err
+	}
+	entry, err := s.auditEntry(ctx, AuditCreateGroup, before, precondition, record)
+	if err != nil {
+		return err
+	}
+	err = s.repo.PutGroupRecord(ctx, record, entry, precondition)
 	if err != nil {
 		return err
 	}
@@ -223,8 +392,10 @@ func (s *ACLService) CreateGroup(ctx context.Context, groupName string, permissi
 	return nil
 }
 
-// CreateGroupWithFieldFilters creates a new group ACL record with field filters
-func (s *ACLService) CreateGroupWithFieldFilters(ctx context.Context, groupName string, permissions map[string]string, fieldFilters map[string]FieldFilter) error {
+// CreateGroupWithFieldFilters creates a new group ACL record inheriting from groups, with field
+// filters and the strategies they merge with other groups' filters by. Permissions and parent
+// groups with an entry in permissionValidity or groupValidity are time-bound.
+func (s *ACLService) CreateGroupWithFieldFilters(ctx context.Context, groupName string, groups []string, permissions map[string]string, fieldFilters map[string]FieldFilter, mergeStrategies map[string]FieldFilterMergeStrategy, permissionValidity, groupValidity map[string]Validity) error {
 	if !isGroupName(groupName) {
 		groupName = "group:" + groupName
 	}
@@ -232,16 +403,30 @@ func (s *ACLService) CreateGroupWithFieldFilters(ctx context.Context, groupName
 	if fieldFilters == nil {
 		fieldFilters = make(map[string]FieldFilter)
 	}
+	if groups == nil {
+		groups = []string{}
+	}
 
 	record := &ACLRecord{
-		PrincipalID:  groupName,
-		Groups:       []string{}, // Groups don't have group memberships
-		Permissions:  permissions,
-		FieldFilters: fieldFilters,
-		UpdatedAt:    time.Now().UTC().Format(time.RFC3339),
+		PrincipalID:        groupName,
+		Groups:             groups, // Groups this group inherits from
+		Permissions:        permissions,
+		FieldFilters:       fieldFilters,
+		MergeStrategies:    mergeStrategies,
+		PermissionValidity: permissionValidity,
+		GroupValidity:      groupValidity,
+		UpdatedAt:          time.Now().UTC().Format(time.RFC3339Nano),
 	}
 
-	err := s.repo.PutGroupRecord(ctx, record)
+	before, precondition, err := s.readRecord(ctx, groupName, nil)
+	if err != nil {
+		return err
+	}
+	entry, err := s.auditEntry(ctx, AuditCreateGroup, before, precondition, record)
+	if err != nil {
+		return err
+	}
+	err = s.repo.PutGroupRecord(ctx, record, entry, precondition)
 	if err != nil {
 		return err
 	}
@@ -251,73 +436,118 @@ func (s *ACLService) CreateGroupWithFieldFilters(ctx context.Context, groupName
 	return nil
 }
 
-// UpdateUserGroups updates a user's group memberships
-func (s *ACLService) UpdateUserGroups(ctx context.Context, email string, groups []string) error {
-	// Get current user record
-	userRecord, err := s.repo.GetUserRecord(ctx, email)
-	if err != nil {
-		return err
-	}
+// RecordUpdate replaces parts of a user or group record. A nil Groups, Permissions or
+// MergeStrategies leaves that part, and the validity windows that go with it, as it is.
+type RecordUpdate struct {
+	Groups             []string // A user's groups, or the groups a group inherits from
+	GroupValidity      map[string]Validity
+	Permissions        map[string]string
+	PermissionValidity map[string]Validity
+	MergeStrategies    map[string]FieldFilterMergeStrategy // Group records only
+}
 
-	// Update groups
-	userRecord.Groups = groups
-	err = s.repo.PutUserRecord(ctx, userRecord)
-	if err != nil {
-		return err
+// apply replaces the parts of record the update sets
+func (u RecordUpdate) apply(record *ACLRecord) {
+	if u.Groups != nil {
+		record.Groups = u.Groups
+		record.GroupValidity = u.GroupValidity
+	}
+	if u.Permissions != nil {
+		record.Permissions = u.Permissions
+		record.PermissionValidity = u.PermissionValidity
 	}
+	if u.MergeStrategies != nil {
+		record.MergeStrategies = u.MergeStrategies
+	}
+}
 
-	// Invalidate cache for this user
-	s.InvalidateCache(email)
-	return nil
+// operation names the update in the audit log after the one part it replaces, or as combined
+// if it replaces several
+func (u RecordUpdate) operation(combined, groups, permissions, mergeStrategies AuditOperation) AuditOperation {
+	var parts []AuditOperation
+	if u.Groups != nil {
+		parts = append(parts, groups)
+	}
+	if u.Permissions != nil {
+		parts = append(parts, permissions)
+	}
+	if u.MergeStrategies != nil {
+		parts = append(parts, mergeStrategies)
+	}
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	return combined
 }
 
-// UpdateUserPermissions updates a user's direct permissions
-func (s *ACLService) UpdateUserPermissions(ctx context.Context, email string, permissions map[string]string) error {
+// UpdateUser replaces the parts of a user's record the update sets, in a single write.
+// A ConflictError is returned if the record no longer matches expected, when set.
+func (s *ACLService) UpdateUser(ctx context.Context, email string, update RecordUpdate, expected *Precondition) (*ACLRecord, error) {
 	// Get current user record
-	userRecord, err := s.repo.GetUserRecord(ctx, email)
+	userRecord, precondition, err := s.readRecord(ctx, email, expected)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	before := *userRecord
 
-	// Update permissions
-	userRecord.Permissions = permissions
-	err = s.repo.PutUserRecord(ctx, userRecord)
+	update.apply(userRecord)
+	operation := update.operation(AuditUpdateUser, AuditUpdateUserGroups, AuditUpdateUserPermissions, AuditUpdateUser)
+	entry, err := s.auditEntry(ctx, operation, &before, precondition, userRecord)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	err = s.repo.PutUserRecord(ctx, userRecord, entry, precondition)
+	if err != nil {
+		return nil, err
 	}
 
 	// Invalidate cache for this user
 	s.InvalidateCache(email)
-	return nil
+	return userRecord, nil
 }
 
-// UpdateGroupPermissions updates a group's permissions
-func (s *ACLService) UpdateGroupPermissions(ctx context.Context, groupName string, permissions map[string]string) error {
+// UpdateGroup replaces the parts of a group's record the update sets, in a single write.
+// Callers check new parent groups with ValidateGroupParents first.
+// A ConflictError is returned if the record no longer matches expected, when set.
+func (s *ACLService) UpdateGroup(ctx context.Context, groupName string, update RecordUpdate, expected *Precondition) (*ACLRecord, error) {
 	if !isGroupName(groupName) {
 		groupName = "group:" + groupName
 	}
 
 	// Get current group record
-	groupRecord, err := s.repo.GetUserRecord(ctx, groupName) // Using GetUserRecord since it's the same operation
+	groupRecord, precondition, err := s.readRecord(ctx, groupName, expected)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	before := *groupRecord
 
-	// Update permissions
-	groupRecord.Permissions = permissions
-	err = s.repo.PutGroupRecord(ctx, groupRecord)
+	update.apply(groupRecord)
+	operation := update.operation(AuditUpdateGroup, AuditUpdateGroupParents, AuditUpdateGroupPermissions, AuditUpdateGroupMergeStrategies)
+	entry, err := s.auditEntry(ctx, operation, &before, precondition, groupRecord)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	err = s.repo.PutGroupRecord(ctx, groupRecord, entry, precondition)
+	if err != nil {
+		return nil, err
 	}
 
-	// Invalidate all cache since group permissions affect multiple users
+	// Invalidate all cache since group permissions, parents and strategies affect multiple users
 	s.InvalidateAllCache()
-	return nil
+	return groupRecord, nil
 }
 
 // DeleteUser removes a user ACL record
 func (s *ACLService) DeleteUser(ctx context.Context, email string) error {
-	err := s.repo.DeleteRecord(ctx, email)
+	before, precondition, err := s.readRecord(ctx, email, nil)
+	if err != nil {
+		return err
+	}
+	entry, err := s.auditEntry(ctx, AuditDeleteUser, before, precondition, nil)
+	if err != nil {
+		return err
+	}
+	err = s.repo.DeleteRecord(ctx, email, entry, precondition)
 	if err != nil {
 		return err
 	}
@@ -332,7 +562,15 @@ func (s *ACLService) DeleteGroup(ctx context.Context, groupName string) error {
 		groupName = "group:" + groupName
 	}
 
-	err := s.repo.DeleteRecord(ctx, groupName)
+	before, precondition, err := s.readRecord(ctx, groupName, nil)
+	if err != nil {
+		return err
+	}
+	entry, err := s.auditEntry(ctx, AuditDeleteGroup, before, precondition, nil)
+	if err != nil {
+		return err
+	}
+	err = s.repo.DeleteRecord(ctx, groupName, entry, precondition)
 	if err != nil {
 		return err
 	}
@@ -342,11 +580,342 @@ func (s *ACLService) DeleteGroup(ctx context.Context, groupName string) error {
 	return nil
 }
 
+// GetRecord returns a principal's record as stored, without its groups merged in, or nil if
+// it does not exist
+func (s *ACLService) GetRecord(ctx context.Context, principalID string) (*ACLRecord, error) {
+	record, _, err := s.repo.getRecord(ctx, principalID)
+	return record, err
+}
+
+// readRecord reads a principal's record to change it, or an empty record if it does not
+// exist. It fails with a ConflictError if the record is not in the expected state (nil expects
+// any), and returns the precondition that keeps the record as read until the change is written.
+func (s *ACLService) readRecord(ctx context.Context, principalID string, expected *Precondition) (*ACLRecord, *Precondition, error) {
+	record, found, err := s.repo.getRecord(ctx, principalID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if expected != nil && !expected.matches(record, found) {
+		return nil, nil, &ConflictError{PrincipalID: principalID, Current: record}
+	}
+
+	if !found {
+		return &ACLRecord{
+			PrincipalID: principalID,
+			Groups:      []string{},
+			Permissions: make(map[string]string),
+		}, &Precondition{Absent: true}, nil
+	}
+	version := record.Version // Copied, as the change renumbers the record
+	return record, &Precondition{Version: &version}, nil
+}
+
+// auditEntry records a change from before, read under precondition, to after (nil for a
+// deletion) as the principal's next version, and numbers after with that version
+func (s *ACLService) auditEntry(ctx context.Context, operation AuditOperation, before *ACLRecord, precondition *Precondition, after *ACLRecord) (*AuditEntry, error) {
+	if precondition.Absent && after == nil {
+		return nil, fmt.Errorf("%s has no ACL record", before.PrincipalID)
+	}
+	latest, err := s.repo.LatestVersion(ctx, before.PrincipalID)
+	if err != nil {
+		return nil, err
+	}
+	if latest == 0 && !precondition.Absent {
+		// Keep the record as it was before versioning began, so its first change can be undone
+		if err := s.repo.PutBaselineVersion(ctx, before); err != nil {
+			return nil, err
+		}
+	}
+
+	entry, err := newAuditEntry(ctx, operation, before, after)
+	if err != nil {
+		return nil, err
+	}
+	entry.Version = latest + 1
+	if after != nil {
+		after.Version = entry.Version
+	}
+	return entry, nil
+}
+
+// ListRecordVersions returns every version of a principal's record, newest first
+func (s *ACLService) ListRecordVersions(ctx context.Context, principalID string) ([]*ACLVersion, error) {
+	return s.repo.ListVersions(ctx, principalID)
+}
+
+// GetRecordVersion returns one version of a principal's record, or nil if it does not exist
+func (s *ACLService) GetRecordVersion(ctx context.Context, principalID string, version int) (*ACLVersion, error) {
+	return s.repo.GetVersion(ctx, principalID, version)
+}
+
+// RollbackRecord restores a principal's record to an earlier version. The restored record is
+// saved as a new version, so a rollback can itself be rolled back.
+func (s *ACLService) RollbackRecord(ctx context.Context, principalID string, version int) (*ACLRecord, error) {
+	target, err := s.repo.GetVersion(ctx, principalID, version)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, fmt.Errorf("%s has no version %d", principalID, version)
+	}
+	if target.Deleted() {
+		return nil, fmt.Errorf("version %d of %s records its deletion; delete the record instead", version, principalID)
+	}
+
+	record := target.Record
+	if isGroupName(principalID) {
+		// The groups the version inherits from may have changed or gone since
+		if err := s.ValidateGroupParents(ctx, principalID, record.Groups); err != nil {
+			return nil, err
+		}
+	}
+
+	before, precondition, err := s.readRecord(ctx, principalID, nil)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := s.auditEntry(ctx, AuditRollback, before, precondition, record)
+	if err != nil {
+		return nil, err
+	}
+
+	if isGroupName(principalID) {
+		err = s.repo.PutGroupRecord(ctx, record, entry, precondition)
+	} else {
+		err = s.repo.PutUserRecord(ctx, record, entry, precondition)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if isGroupName(principalID) {
+		// Invalidate all cache since group permissions affect multiple users
+		s.InvalidateAllCache()
+	} else {
+		s.InvalidateCache(principalID)
+	}
+	return record, nil
+}
+
+// ListAuditEntries returns the audit entries matching the filter, newest first
+func (s *ACLService) ListAuditEntries(ctx context.Context, filter AuditFilter) ([]*AuditEntry, error) {
+	return s.repo.ListAuditEntries(ctx, filter)
+}
+
 // ListAllRecords returns all ACL records (for administrative purposes)
 func (s *ACLService) ListAllRecords(ctx context.Context) ([]*ACLRecord, error) {
 	return s.repo.ListRecords(ctx)
 }
 
+// ExportRows lists every ACL record as the rows of an ACL file
+func (s *ACLService) ExportRows(ctx context.Context) ([]ACLRow, error) {
+	records, err := s.repo.ListRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return RecordsToRows(records), nil
+}
+
+// ImportRecords validates the rows of an ACL file and, unless dryRun, writes the records they
+// describe; principals without rows keep their records. Nothing is written if any row is
+// invalid. Records are written MaxRecordsPerTransaction at a time, each batch in one
+// transaction, so a batch that fails leaves the others written and its rows marked failed.
+// knownFieldFilter reports whether a field filter key names a field some dataset has.
+func (s *ACLService) ImportRecords(ctx context.Context, rows []ACLRow, mode ImportMode, dryRun bool, knownFieldFilter func(string) bool) (*ImportResult, error) {
+	if mode != ImportMerge && mode != ImportReplace {
+		return nil, fmt.Errorf("unknown import mode %q", mode)
+	}
+
+	result := &ImportResult{Rows: make([]ImportRowResult, len(rows))}
+	invalid := func(i int, err error) {
+		result.Rows[i].Status = ImportRowInvalid
+		result.Rows[i].Message = err.Error()
+	}
+
+	// Collect the rows of each principal into the record they describe
+	imported := make(map[string]*ACLRecord)
+	var principals []string
+	for i, row := range rows {
+		result.Rows[i] = ImportRowResult{Line: row.Line, PrincipalID: row.PrincipalID}
+		if row.PrincipalID == "" {
+			invalid(i, errors.New("principalID is required"))
+			continue
+		}
+		record, ok := imported[row.PrincipalID]
+		if !ok {
+			record = newImportedRecord(row.PrincipalID)
+			imported[row.PrincipalID] = record
+			principals = append(principals, row.PrincipalID)
+		}
+		if err := applyImportRow(record, row, knownFieldFilter); err != nil {
+			invalid(i, err)
+		}
+	}
+
+	records, err := s.repo.ListRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]*ACLRecord, len(records))
+	for _, record := range records {
+		existing[record.PrincipalID] = record
+	}
+
+	planned := make(map[string]*ACLRecord, len(principals))
+	statuses := make(map[string]ImportRowStatus, len(principals))
+	for _, principalID := range principals {
+		current, found := existing[principalID]
+		switch {
+		case !found:
+			planned[principalID] = imported[principalID]
+			statuses[principalID] = ImportRowCreate
+		case mode == ImportMerge:
+			planned[principalID] = mergeImportedRecord(current, imported[principalID])
+		default:
+			planned[principalID] = imported[principalID]
+		}
+		if found {
+			statuses[principalID] = ImportRowUpdate
+			if len(DiffACLRecords(current, planned[principalID])) == 0 {
+				statuses[principalID] = ImportRowUnchanged
+			}
+		}
+	}
+
+	// Group inheritance is checked as it will be once every record is written
+	getGroups := func(groups []string) ([]*ACLRecord, error) {
+		var found []*ACLRecord
+		for _, group := range groups {
+			if record, ok := planned[group]; ok {
+				found = append(found, record)
+			} else if record, ok := existing[group]; ok {
+				found = append(found, record)
+			}
+		}
+		return found, nil
+	}
+	for _, principalID := range principals {
+		if !isGroupName(principalID) || len(imported[principalID].Groups) == 0 {
+			continue
+		}
+		if err := validateGroupParents(principalID, planned[principalID].Groups, getGroups); err != nil {
+			for i, row := range rows {
+				if row.PrincipalID == principalID && row.Kind == RowKindGroup && result.Rows[i].Status == "" {
+					invalid(i, err)
+				}
+			}
+		}
+	}
+
+	for i, row := range rows {
+		if result.Rows[i].Status != "" {
+			continue
+		}
+		status := statuses[row.PrincipalID]
+		switch {
+		// The admin group can only be kept as it is, so files exported with it import again
+		case (row.PrincipalID == "admin" || row.PrincipalID == "group:admin") && status != ImportRowUnchanged:
+			invalid(i, errors.New("cannot modify admin group through ACL configuration"))
+		case row.Kind == RowKindGroup && row.Name == "group:admin" &&
+			(existing[row.PrincipalID] == nil || !slices.Contains(existing[row.PrincipalID].Groups, row.Name)):
+			invalid(i, errors.New("cannot assign admin group through ACL configuration"))
+		// A replacing file that leaves out a member's admin row would otherwise drop the membership
+		case existing[row.PrincipalID] != nil && slices.Contains(existing[row.PrincipalID].Groups, "group:admin") &&
+			!slices.Contains(planned[row.PrincipalID].Groups, "group:admin"):
+			invalid(i, errors.New("cannot remove admin group through ACL configuration"))
+		default:
+			result.Rows[i].Status = status
+		}
+	}
+
+	if dryRun || result.Count(ImportRowInvalid) > 0 {
+		return result, nil
+	}
+	result.Applied = true
+
+	var pending []string
+	for _, principalID := range principals {
+		if statuses[principalID] != ImportRowUnchanged {
+			pending = append(pending, principalID)
+		}
+	}
+
+	written := false
+	for batch := range slices.Chunk(pending, MaxRecordsPerTransaction) {
+		err := s.importBatch(ctx, batch, existing, planned)
+		if err == nil {
+			written = true
+			continue
+		}
+
+		var conflict *ConflictError
+		errors.As(err, &conflict)
+		for i, row := range rows {
+			if !slices.Contains(batch, row.PrincipalID) {
+				continue
+			}
+			result.Rows[i].Status = ImportRowFailed
+			result.Rows[i].Message = err.Error()
+			if conflict != nil && conflict.PrincipalID != row.PrincipalID {
+				result.Rows[i].Message = fmt.Sprintf("not written, as writing %s in the same transaction failed: %v", conflict.PrincipalID, err)
+			}
+		}
+	}
+
+	if written {
+		// Invalidate all cache since imported groups affect multiple users
+		s.InvalidateAllCache()
+	}
+	return result, nil
+}
+
+// importBatch writes the planned records of a batch of principals in one transaction, each
+// based on its existing record, if any. Baselines of records that have no versions yet are
+// stored before the transaction; one left by a failed batch still holds the record as it is.
+func (s *ACLService) importBatch(ctx context.Context, principals []string, existing, planned map[string]*ACLRecord) error {
+	writes := make([]RecordWrite, 0, len(principals))
+	for _, principalID := range principals {
+		before, precondition := &ACLRecord{PrincipalID: principalID}, &Precondition{Absent: true}
+		if current, ok := existing[principalID]; ok {
+			version := current.Version // Copied, as the change renumbers the record
+			before, precondition = current, &Precondition{Version: &version}
+		}
+
+		entry, err := s.auditEntry(ctx, AuditImport, before, precondition, planned[principalID])
+		if err != nil {
+			return err
+		}
+		writes = append(writes, RecordWrite{Record: planned[principalID], Entry: entry, Precondition: precondition})
+	}
+
+	return s.repo.PutRecords(ctx, writes)
+}
+
+// ListExpiringGrants returns the grants in force now that end within the given window,
+// soonest first
+func (s *ACLService) ListExpiringGrants(ctx context.Context, within time.Duration) ([]ExpiringGrant, error) {
+	records, err := s.repo.ListRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var expiring []ExpiringGrant
+	for _, record := range records {
+		expiring = append(expiring, record.ExpiringGrants(now, now.Add(within))...)
+	}
+	slices.SortFunc(expiring, func(a, b ExpiringGrant) int {
+		return cmp.Or(
+			a.ValidUntil.Compare(b.ValidUntil),
+			strings.Compare(a.PrincipalID, b.PrincipalID),
+			strings.Compare(string(a.Kind), string(b.Kind)),
+			strings.Compare(a.Name, b.Name),
+		)
+	})
+
+	return expiring, nil
+}
+
 // GetCacheStats returns cache statistics
 func (s *ACLService) GetCacheStats() (int, int) {
 	s.mutex.RLock()
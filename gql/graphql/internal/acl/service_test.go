@@ -0,0 +1,226 @@
+package acl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"ssot/gql/graphql/internal/auth"
+	"ssot/gql/graphql/internal/dynamotest"
+)
+
+func TestMergePermissionsPrecedence(t *testing.T) {
+	group := func(name string, permissions map[string]string) *ACLRecord {
+		return &ACLRecord{PrincipalID: name, Permissions: permissions}
+	}
+
+	tests := []struct {
+		name   string
+		user   map[string]string
+		levels [][]*ACLRecord
+		want   string
+	}{
+		{
+			name: "blocking beats read within a level",
+			levels: [][]*ACLRecord{{
+				group("group:a", map[string]string{"LoanInfo#*": "read"}),
+				group("group:b", map[string]string{"LoanInfo#*": "blocking"}),
+			}},
+			want: "blocking",
+		},
+		{
+			name: "read beats write within a level",
+			levels: [][]*ACLRecord{{
+				group("group:a", map[string]string{"LoanInfo#*": "readwrite"}),
+				group("group:b", map[string]string{"LoanInfo#*": "read"}),
+			}},
+			want: "read",
+		},
+		{
+			name: "order within a level does not matter",
+			levels: [][]*ACLRecord{{
+				group("group:b", map[string]string{"LoanInfo#*": "blocking"}),
+				group("group:a", map[string]string{"LoanInfo#*": "readwrite"}),
+			}},
+			want: "blocking",
+		},
+		{
+			name: "a nearer level wins over a more restrictive farther one",
+			levels: [][]*ACLRecord{
+				{group("group:child", map[string]string{"LoanInfo#*": "readwrite"})},
+				{group("group:parent", map[string]string{"LoanInfo#*": "blocking"})},
+			},
+			want: "readwrite",
+		},
+		{
+			name: "the user's own permission wins",
+			user: map[string]string{"LoanInfo#*": "read"},
+			levels: [][]*ACLRecord{{
+				group("group:a", map[string]string{"LoanInfo#*": "blocking"}),
+			}},
+			want: "read",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergePermissions(tt.user, tt.levels)["LoanInfo#*"]; got != tt.want {
+				t.Errorf("LoanInfo#* = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// newImportService serves an ACL table holding items to an ACLService, acting as an admin
+func newImportService(t *testing.T, items ...dynamotest.Item) (*ACLService, *dynamotest.Server, context.Context) {
+	server := dynamotest.NewServer(t)
+	server.Handle("Scan", func(input map[string]any) (any, error) {
+		return dynamotest.Page(items, input, "PrincipalID"), nil
+	})
+	server.Handle("Query", func(input map[string]any) (any, error) {
+		return dynamotest.Page(nil, input), nil
+	})
+	server.Handle("PutItem", func(input map[string]any) (any, error) {
+		return map[string]any{}, nil
+	})
+	server.Handle("TransactWriteItems", func(input map[string]any) (any, error) {
+		return map[string]any{}, nil
+	})
+
+	repo := NewDynamoRepository(server.Client(), "acl", "acl-audit", "acl-versions")
+	ctx := context.WithValue(t.Context(), auth.UserContextKey, &auth.User{Email: "admin@example.com"})
+	return NewACLService(t.Context(), repo, 0), server, ctx
+}
+
+func TestImportRecordsGuardsAdminGroup(t *testing.T) {
+	adminGroup := dynamotest.Item{
+		"PrincipalID": dynamotest.S("group:admin"),
+		"Version":     dynamotest.N("1"),
+		"Permissions": map[string]any{"M": map[string]any{"LoanInfo#*": dynamotest.S("read")}},
+	}
+	adminMember := dynamotest.Item{
+		"PrincipalID": dynamotest.S("boss@example.com"),
+		"Version":     dynamotest.N("1"),
+		"Groups":      map[string]any{"L": []any{dynamotest.S("group:admin")}},
+	}
+
+	tests := []struct {
+		name        string
+		row         ACLRow
+		mode        ImportMode
+		wantStatus  ImportRowStatus
+		wantMessage string
+	}{
+		{
+			name:        "changing the admin group",
+			row:         ACLRow{PrincipalID: "group:admin", Kind: RowKindPermission, Name: "LoanInfo#*", Value: "readwrite"},
+			mode:        ImportMerge,
+			wantStatus:  ImportRowInvalid,
+			wantMessage: "cannot modify admin group",
+		},
+		{
+			name:       "keeping the admin group as it is",
+			row:        ACLRow{PrincipalID: "group:admin", Kind: RowKindPermission, Name: "LoanInfo#*", Value: "read"},
+			mode:       ImportReplace,
+			wantStatus: ImportRowUnchanged,
+		},
+		{
+			name:        "assigning the admin group",
+			row:         ACLRow{PrincipalID: "user@example.com", Kind: RowKindGroup, Name: "group:admin"},
+			mode:        ImportMerge,
+			wantStatus:  ImportRowInvalid,
+			wantMessage: "cannot assign admin group",
+		},
+		{
+			name:        "removing the admin group",
+			row:         ACLRow{PrincipalID: "boss@example.com", Kind: RowKindPermission, Name: "LoanInfo#*", Value: "read"},
+			mode:        ImportReplace,
+			wantStatus:  ImportRowInvalid,
+			wantMessage: "cannot remove admin group",
+		},
+		{
+			name:       "keeping an admin membership",
+			row:        ACLRow{PrincipalID: "boss@example.com", Kind: RowKindGroup, Name: "group:admin"},
+			mode:       ImportReplace,
+			wantStatus: ImportRowUnchanged,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, server, ctx := newImportService(t, adminGroup, adminMember)
+
+			result, err := service.ImportRecords(ctx, []ACLRow{tt.row}, tt.mode, false, func(string) bool { return true })
+			if err != nil {
+				t.Fatalf("ImportRecords: %v", err)
+			}
+			row := result.Rows[0]
+			if row.Status != tt.wantStatus || !strings.Contains(row.Message, tt.wantMessage) {
+				t.Errorf("row = %s %q, want %s %q", row.Status, row.Message, tt.wantStatus, tt.wantMessage)
+			}
+			if writes := len(server.Requests("TransactWriteItems")); writes != 0 {
+				t.Errorf("ran %d transactions, want none", writes)
+			}
+		})
+	}
+}
+
+func TestImportRecordsBatchesTransactions(t *testing.T) {
+	var rows []ACLRow
+	for i := range 2*MaxRecordsPerTransaction + 4 {
+		rows = append(rows, ACLRow{Line: i + 1, PrincipalID: fmt.Sprintf("user%02d@example.com", i), Kind: RowKindRecord})
+	}
+
+	service, server, ctx := newImportService(t)
+	server.Handle("TransactWriteItems", func(input map[string]any) (any, error) {
+		// The second transaction finds its second record already created
+		if len(server.Requests("TransactWriteItems")) != 2 {
+			return map[string]any{}, nil
+		}
+		reasons := make([]any, 3*MaxRecordsPerTransaction)
+		for i := range reasons {
+			reasons[i] = map[string]any{"Code": "None"}
+		}
+		reasons[3] = map[string]any{"Code": "ConditionalCheckFailed"}
+		return nil, &dynamotest.Error{
+			Type:    "TransactionCanceledException",
+			Message: "Transaction cancelled",
+			Fields:  map[string]any{"CancellationReasons": reasons},
+		}
+	})
+	server.Handle("GetItem", func(input map[string]any) (any, error) {
+		return map[string]any{}, nil
+	})
+
+	result, err := service.ImportRecords(ctx, rows, ImportMerge, false, func(string) bool { return true })
+	if err != nil {
+		t.Fatalf("ImportRecords: %v", err)
+	}
+	if !result.Applied {
+		t.Error("import was not applied")
+	}
+
+	requests := server.Requests("TransactWriteItems")
+	var sizes []int
+	for _, request := range requests {
+		items, _ := request["TransactItems"].([]any)
+		sizes = append(sizes, len(items))
+	}
+	if want := []int{99, 99, 12}; fmt.Sprint(sizes) != fmt.Sprint(want) {
+		t.Errorf("transaction sizes = %v, want %v", sizes, want)
+	}
+
+	conflicting := rows[MaxRecordsPerTransaction+1].PrincipalID
+	for i, row := range result.Rows {
+		inFailedBatch := i >= MaxRecordsPerTransaction && i < 2*MaxRecordsPerTransaction
+		switch {
+		case !inFailedBatch && row.Status != ImportRowCreate:
+			t.Errorf("row %d = %s %q, want create", row.Line, row.Status, row.Message)
+		case inFailedBatch && row.Status != ImportRowFailed:
+			t.Errorf("row %d = %s, want failed with its batch", row.Line, row.Status)
+		case inFailedBatch && row.PrincipalID != conflicting && !strings.Contains(row.Message, "writing "+conflicting):
+			t.Errorf("row %d message %q does not name the conflicting %s", row.Line, row.Message, conflicting)
+		}
+	}
+}
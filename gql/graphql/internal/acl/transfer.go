@@ -0,0 +1,530 @@
+package acl
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// ACLFileFormat is a file format ACL records are exported to and imported from
+type ACLFileFormat string
+
+const (
+	FormatJSON ACLFileFormat = "json" // An array of row objects
+	FormatCSV  ACLFileFormat = "csv"  // A header row naming the columns, then one line per row
+	FormatXLSX ACLFileFormat = "xlsx" // As CSV, on the first sheet of a workbook
+)
+
+// ACLRowKind is the kind of grant an ACL file row holds
+type ACLRowKind string
+
+const (
+	RowKindRecord        ACLRowKind = "record"         // A principal without grants
+	RowKindGroup         ACLRowKind = "group"          // name is a user's group, or a group a group inherits from
+	RowKindPermission    ACLRowKind = "permission"     // name is "Table#column", value the action
+	RowKindFieldFilter   ACLRowKind = "field_filter"   // name is the field filter key, value the filter type
+	RowKindMergeStrategy ACLRowKind = "merge_strategy" // name is the field filter key, value the strategy (groups only)
+)
+
+const (
+	// maxImportRows bounds the rows of an imported file
+	maxImportRows = 10000
+	// xlsxSheetName names the sheet exported workbooks hold their rows on
+	xlsxSheetName = "ACL"
+)
+
+// aclFileColumns are the columns of CSV and XLSX files, in export order. List columns hold JSON
+// arrays, so values may contain any character.
+var aclFileColumns = []string{"principalID", "kind", "name", "value", "includeList", "excludeList", "validFrom", "validUntil"}
+
+// ACLRow is one grant of one principal in an ACL file. A record is the set of rows with its
+// principal ID. validFrom and validUntil are RFC 3339 timestamps, as in the GraphQL API.
+type ACLRow struct {
+	Line        int        `json:"-"` // Row of the sheet or line of the CSV file, or position in the JSON array
+	PrincipalID string     `json:"principalID"`
+	Kind        ACLRowKind `json:"kind"`
+	Name        string     `json:"name,omitempty"`
+	Value       string     `json:"value,omitempty"`
+	IncludeList []string   `json:"includeList,omitempty"`
+	ExcludeList []string   `json:"excludeList,omitempty"`
+	ValidFrom   string     `json:"validFrom,omitempty"`
+	ValidUntil  string     `json:"validUntil,omitempty"`
+
+	err error // Why the row could not be read, reported when it is imported
+}
+
+// RecordsToRows lists the rows of ACL records, by principal and then kind and name
+func RecordsToRows(records []*ACLRecord) []ACLRow {
+	records = slices.Clone(records)
+	slices.SortFunc(records, func(a, b *ACLRecord) int {
+		return strings.Compare(a.PrincipalID, b.PrincipalID)
+	})
+
+	var rows []ACLRow
+	for _, record := range records {
+		first := len(rows)
+		add := func(row ACLRow, validity Validity) {
+			row.PrincipalID = record.PrincipalID
+			row.ValidFrom = formatRowTime(validity.ValidFrom)
+			row.ValidUntil = formatRowTime(validity.ValidUntil)
+			rows = append(rows, row)
+		}
+
+		for _, group := range record.Groups {
+			add(ACLRow{Kind: RowKindGroup, Name: group}, record.GroupValidity[group])
+		}
+		for _, key := range slices.Sorted(maps.Keys(record.Permissions)) {
+			add(ACLRow{Kind: RowKindPermission, Name: key, Value: record.Permissions[key]}, record.PermissionValidity[key])
+		}
+		for _, key := range slices.Sorted(maps.Keys(record.FieldFilters)) {
+			filter := record.FieldFilters[key]
+			add(ACLRow{
+				Kind:        RowKindFieldFilter,
+				Name:        key,
+				Value:       filter.FilterType,
+				IncludeList: filter.IncludeList,
+				ExcludeList: filter.ExcludeList,
+			}, filter.Validity)
+		}
+		for _, key := range slices.Sorted(maps.Keys(record.MergeStrategies)) {
+			add(ACLRow{Kind: RowKindMergeStrategy, Name: key, Value: string(record.MergeStrategies[key])}, Validity{})
+		}
+
+		if len(rows) == first {
+			add(ACLRow{Kind: RowKindRecord}, Validity{})
+		}
+	}
+
+	for i := range rows {
+		rows[i].Line = i + 1
+	}
+	return rows
+}
+
+// WriteACLFile writes rows in a file format
+func WriteACLFile(w io.Writer, format ACLFileFormat, rows []ACLRow) error {
+	switch format {
+	case FormatJSON:
+		if rows == nil {
+			rows = []ACLRow{}
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(rows)
+
+	case FormatCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(aclFileColumns); err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if err := writer.Write(row.cells()); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+
+	case FormatXLSX:
+		file := excelize.NewFile()
+		defer file.Close()
+		if err := file.SetSheetName(file.GetSheetName(0), xlsxSheetName); err != nil {
+			return err
+		}
+
+		header := make([]any, len(aclFileColumns))
+		for i, column := range aclFileColumns {
+			header[i] = column
+		}
+		if err := file.SetSheetRow(xlsxSheetName, "A1", &header); err != nil {
+			return err
+		}
+		for i, row := range rows {
+			cells := row.cells()
+			values := make([]any, len(cells))
+			for j, cell := range cells {
+				values[j] = cell
+			}
+			// Rows are 1-based and the header is the first
+			if err := file.SetSheetRow(xlsxSheetName, fmt.Sprintf("A%d", i+2), &values); err != nil {
+				return err
+			}
+		}
+		return file.Write(w)
+
+	default:
+		return fmt.Errorf("unsupported ACL file format %q", format)
+	}
+}
+
+// ReadACLFile reads the rows of a file. Rows that cannot be read are returned with the reason,
+// which importing them reports; a file that cannot be read at all is an error.
+func ReadACLFile(r io.Reader, format ACLFileFormat) ([]ACLRow, error) {
+	var rows []ACLRow
+	switch format {
+	case FormatJSON:
+		var raw []json.RawMessage
+		if err := json.NewDecoder(r).Decode(&raw); err != nil {
+			return nil, fmt.Errorf("a JSON ACL file must hold an array of rows: %w", err)
+		}
+		if len(raw) > maxImportRows {
+			return nil, fmt.Errorf("the file has %d rows, more than the %d allowed", len(raw), maxImportRows)
+		}
+		for i, message := range raw {
+			var row ACLRow
+			if err := json.Unmarshal(message, &row); err != nil {
+				row.err = err
+			}
+			row.Line = i + 1
+			rows = append(rows, row)
+		}
+		return rows, nil
+
+	case FormatCSV:
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1 // Short lines leave the trailing columns empty
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		return rowsFromCells(records)
+
+	case FormatXLSX:
+		file, err := excelize.OpenReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open workbook: %w", err)
+		}
+		defer file.Close()
+
+		sheets := file.GetSheetList()
+		if len(sheets) == 0 {
+			return nil, errors.New("the workbook has no sheets")
+		}
+		cells, err := file.GetRows(sheets[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read sheet %s: %w", sheets[0], err)
+		}
+		return rowsFromCells(cells)
+
+	default:
+		return nil, fmt.Errorf("unsupported ACL file format %q", format)
+	}
+}
+
+// rowsFromCells reads the rows of a CSV file or sheet, whose first line names the columns.
+// Lines are numbered from 1 for the header, and blank lines are skipped.
+func rowsFromCells(lines [][]string) ([]ACLRow, error) {
+	if len(lines) == 0 {
+		return nil, errors.New("the file is empty; the first line must name the columns")
+	}
+	if len(lines)-1 > maxImportRows {
+		return nil, fmt.Errorf("the file has %d rows, more than the %d allowed", len(lines)-1, maxImportRows)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range lines[0] {
+		name = strings.TrimSpace(name)
+		if !slices.Contains(aclFileColumns, name) {
+			return nil, fmt.Errorf("unknown column %q; columns are %s", name, strings.Join(aclFileColumns, ", "))
+		}
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("column %q appears twice", name)
+		}
+		columns[name] = i
+	}
+	for _, required := range []string{"principalID", "kind"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("missing column %q", required)
+		}
+	}
+
+	var rows []ACLRow
+	for i, line := range lines[1:] {
+		if !slices.ContainsFunc(line, func(cell string) bool { return strings.TrimSpace(cell) != "" }) {
+			continue
+		}
+
+		cell := func(name string) string {
+			if index, ok := columns[name]; ok && index < len(line) {
+				return strings.TrimSpace(line[index])
+			}
+			return ""
+		}
+		row := ACLRow{
+			Line:        i + 2,
+			PrincipalID: cell("principalID"),
+			Kind:        ACLRowKind(strings.ToLower(cell("kind"))),
+			Name:        cell("name"),
+			Value:       cell("value"),
+			ValidFrom:   cell("validFrom"),
+			ValidUntil:  cell("validUntil"),
+		}
+		for _, list := range []struct {
+			name   string
+			target *[]string
+		}{{"includeList", &row.IncludeList}, {"excludeList", &row.ExcludeList}} {
+			if value := cell(list.name); value != "" {
+				if err := json.Unmarshal([]byte(value), list.target); err != nil && row.err == nil {
+					row.err = fmt.Errorf("%s must be a JSON array of strings, such as [\"MAV-*\"]: %w", list.name, err)
+				}
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// cells renders a row as CSV or sheet cells, in the order of aclFileColumns
+func (row ACLRow) cells() []string {
+	list := func(values []string) string {
+		if len(values) == 0 {
+			return ""
+		}
+		encoded, _ := json.Marshal(values) // A list of strings always encodes
+		return string(encoded)
+	}
+	return []string{
+		row.PrincipalID,
+		string(row.Kind),
+		row.Name,
+		row.Value,
+		list(row.IncludeList),
+		list(row.ExcludeList),
+		row.ValidFrom,
+		row.ValidUntil,
+	}
+}
+
+// formatRowTime renders a validity bound for an ACL file, or "" if it is open
+func formatRowTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// ImportMode is how an imported record combines with the principal's existing record
+type ImportMode string
+
+const (
+	ImportMerge   ImportMode = "merge"   // Imported grants are added, replacing grants of the same group or key
+	ImportReplace ImportMode = "replace" // The record becomes exactly the imported grants
+)
+
+// ImportRowStatus is the outcome of one imported row
+type ImportRowStatus string
+
+const (
+	ImportRowInvalid   ImportRowStatus = "invalid"   // The row is malformed or not allowed
+	ImportRowCreate    ImportRowStatus = "create"    // The row's principal has no record yet
+	ImportRowUpdate    ImportRowStatus = "update"    // The import changes the principal's record
+	ImportRowUnchanged ImportRowStatus = "unchanged" // The principal's record already holds what the file does
+	ImportRowFailed    ImportRowStatus = "failed"    // Writing the principal's record failed
+)
+
+// ImportRowResult is the outcome of one row of an imported file
+type ImportRowResult struct {
+	Line        int
+	PrincipalID string
+	Status      ImportRowStatus
+	Message     string // Why the row is invalid or failed
+}
+
+// ImportResult is the outcome of an import. Unless Applied, nothing was written and the row
+// statuses tell what the import would do.
+type ImportResult struct {
+	Rows    []ImportRowResult
+	Applied bool
+}
+
+// Count returns the number of rows with a status
+func (r *ImportResult) Count(status ImportRowStatus) int {
+	count := 0
+	for _, row := range r.Rows {
+		if row.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// applyImportRow adds the grant of a row to the record of its principal, which collects the
+// principal's rows read so far. knownFieldFilter reports whether a field filter key names a
+// field some dataset has.
+func applyImportRow(record *ACLRecord, row ACLRow, knownFieldFilter func(string) bool) error {
+	if row.err != nil {
+		return row.err
+	}
+
+	validity, err := parseRowValidity(row)
+	if err != nil {
+		return err
+	}
+
+	switch row.Kind {
+	case RowKindRecord:
+		if row.Name != "" || row.Value != "" || validity != (Validity{}) {
+			return errors.New("a record row only names its principal")
+		}
+
+	case RowKindGroup:
+		if !isGroupName(row.Name) {
+			return fmt.Errorf("group name '%s' must begin with 'group:'", row.Name)
+		}
+		if slices.Contains(record.Groups, row.Name) {
+			return fmt.Errorf("group %s is listed twice for %s", row.Name, record.PrincipalID)
+		}
+		record.Groups = append(record.Groups, row.Name)
+		if validity != (Validity{}) {
+			record.GroupValidity[row.Name] = validity
+		}
+
+	case RowKindPermission:
+		table, column, ok := strings.Cut(row.Name, "#")
+		if !ok || table == "" || column == "" {
+			return fmt.Errorf("permission '%s' must be Table#column, or Table#* for the whole table", row.Name)
+		}
+		if !PermissionAction(row.Value).IsValid() {
+			return fmt.Errorf("unknown action '%s' for %s; actions are read, write, readwrite and blocking", row.Value, row.Name)
+		}
+		if _, ok := record.Permissions[row.Name]; ok {
+			return fmt.Errorf("permission %s is listed twice for %s", row.Name, record.PrincipalID)
+		}
+		record.Permissions[row.Name] = row.Value
+		if validity != (Validity{}) {
+			record.PermissionValidity[row.Name] = validity
+		}
+
+	case RowKindFieldFilter:
+		if !knownFieldFilter(row.Name) {
+			return fmt.Errorf("unknown field %s", row.Name)
+		}
+		if _, ok := record.FieldFilters[row.Name]; ok {
+			return fmt.Errorf("field filter %s is listed twice for %s", row.Name, record.PrincipalID)
+		}
+		filter := FieldFilter{
+			Field:       row.Name,
+			IncludeList: row.IncludeList,
+			ExcludeList: row.ExcludeList,
+			FilterType:  row.Value,
+			Validity:    validity,
+		}
+		if err := filter.Validate(); err != nil {
+			return fmt.Errorf("field %s: %w", row.Name, err)
+		}
+		record.FieldFilters[row.Name] = filter
+
+	case RowKindMergeStrategy:
+		if !isGroupName(record.PrincipalID) {
+			return errors.New("only groups have merge strategies")
+		}
+		if !knownFieldFilter(row.Name) {
+			return fmt.Errorf("unknown field %s", row.Name)
+		}
+		strategy := FieldFilterMergeStrategy(strings.ToLower(row.Value))
+		if !strategy.IsValid() {
+			return fmt.Errorf("unknown merge strategy '%s'; strategies are union, intersection and most_restrictive", row.Value)
+		}
+		if validity != (Validity{}) {
+			return errors.New("merge strategies have no validity")
+		}
+		if _, ok := record.MergeStrategies[row.Name]; ok {
+			return fmt.Errorf("merge strategy of %s is listed twice for %s", row.Name, record.PrincipalID)
+		}
+		record.MergeStrategies[row.Name] = strategy
+
+	default:
+		return fmt.Errorf("unknown kind '%s'; kinds are record, group, permission, field_filter and merge_strategy", row.Kind)
+	}
+
+	return nil
+}
+
+// parseRowValidity reads the validity window of a row; missing bounds stay open
+func parseRowValidity(row ACLRow) (Validity, error) {
+	var validity Validity
+	for _, bound := range []struct {
+		name   string
+		value  string
+		target *time.Time
+	}{
+		{"validFrom", row.ValidFrom, &validity.ValidFrom},
+		{"validUntil", row.ValidUntil, &validity.ValidUntil},
+	} {
+		if bound.value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, bound.value)
+		if err != nil {
+			return validity, fmt.Errorf("%s must be an RFC 3339 timestamp: %w", bound.name, err)
+		}
+		*bound.target = parsed
+	}
+
+	if !validity.ValidFrom.IsZero() && !validity.ValidUntil.IsZero() && !validity.ValidUntil.After(validity.ValidFrom) {
+		return validity, errors.New("validUntil must be after validFrom")
+	}
+	return validity, nil
+}
+
+// newImportedRecord returns the empty record a principal's imported rows are collected in
+func newImportedRecord(principalID string) *ACLRecord {
+	return &ACLRecord{
+		PrincipalID:        principalID,
+		Groups:             []string{},
+		Permissions:        make(map[string]string),
+		FieldFilters:       make(map[string]FieldFilter),
+		MergeStrategies:    make(map[string]FieldFilterMergeStrategy),
+		PermissionValidity: make(map[string]Validity),
+		GroupValidity:      make(map[string]Validity),
+	}
+}
+
+// mergeImportedRecord adds the grants of an imported record to an existing one. A group or key
+// the existing record already has takes the imported grant, validity included.
+func mergeImportedRecord(existing, imported *ACLRecord) *ACLRecord {
+	merged := *existing
+	merged.Groups = slices.Clone(existing.Groups)
+	merged.Permissions = cloneMap(existing.Permissions)
+	merged.FieldFilters = cloneMap(existing.FieldFilters)
+	merged.MergeStrategies = cloneMap(existing.MergeStrategies)
+	merged.PermissionValidity = cloneMap(existing.PermissionValidity)
+	merged.GroupValidity = cloneMap(existing.GroupValidity)
+
+	for _, group := range imported.Groups {
+		if !slices.Contains(merged.Groups, group) {
+			merged.Groups = append(merged.Groups, group)
+		}
+		delete(merged.GroupValidity, group)
+		if validity, ok := imported.GroupValidity[group]; ok {
+			merged.GroupValidity[group] = validity
+		}
+	}
+	for key, action := range imported.Permissions {
+		merged.Permissions[key] = action
+		delete(merged.PermissionValidity, key)
+		if validity, ok := imported.PermissionValidity[key]; ok {
+			merged.PermissionValidity[key] = validity
+		}
+	}
+	maps.Copy(merged.FieldFilters, imported.FieldFilters)
+	maps.Copy(merged.MergeStrategies, imported.MergeStrategies)
+
+	return &merged
+}
+
+// cloneMap copies a map, returning an empty map rather than nil
+func cloneMap[M ~map[K]V, K comparable, V any](m M) M {
+	clone := make(M, len(m))
+	maps.Copy(clone, m)
+	return clone
+}
@@ -1,6 +1,11 @@
 package acl
 
 import (
+	"cmp"
+	"maps"
+	"math"
+	"slices"
+	"strings"
 	"time"
 )
 
@@ -8,21 +13,44 @@ import (
 // Each entry contains the principal ID (email for users, group:name for groups)
 // and their associated permissions
 type ACLRecord struct {
-	PrincipalID  string                 `dynamodbav:"PrincipalID"`  // "paul@mavik.com" or "group:admin"
-	Groups       []string               `dynamodbav:"Groups"`       // User's group memberships (empty for group entries)
-	Permissions  map[string]string      `dynamodbav:"Permissions"`  // Permission mappings
-	FieldFilters map[string]FieldFilter `dynamodbav:"FieldFilters"` // Field-level include/exclude filters
-	UpdatedAt    string                 `dynamodbav:"UpdatedAt"`    // Last update timestamp
+	PrincipalID     string                              `dynamodbav:"PrincipalID"`     // "paul@mavik.com" or "group:admin"
+	Groups          []string                            `dynamodbav:"Groups"`          // A user's groups, or the groups a group inherits from
+	Permissions     map[string]string                   `dynamodbav:"Permissions"`     // Permission mappings
+	FieldFilters    map[string]FieldFilter              `dynamodbav:"FieldFilters"`    // Field-level include/exclude filters, by "field" or "Table.field"
+	MergeStrategies map[string]FieldFilterMergeStrategy `dynamodbav:"MergeStrategies"` // How field filters combine with other groups', by field filter key (group entries only)
+	UpdatedAt       string                              `dynamodbav:"UpdatedAt"`       // Last update timestamp
+	Version         int                                 `dynamodbav:"Version"`         // Number of the record's latest version (0 before versioning)
+
+	// Validity windows of time-bound permissions, by permission key, and group memberships,
+	// by group. Grants without an entry always apply.
+	PermissionValidity map[string]Validity `dynamodbav:"PermissionValidity"`
+	GroupValidity      map[string]Validity `dynamodbav:"GroupValidity"`
 }
 
 // FieldFilter defines include/exclude rules for specific field values
 type FieldFilter struct {
-	Field       string   `dynamodbav:"Field"`       // Field name (e.g., "loancode", "propertycode")
-	IncludeList []string `dynamodbav:"IncludeList"` // Values to include (empty means all allowed)
-	ExcludeList []string `dynamodbav:"ExcludeList"` // Values to exclude (takes precedence over include)
+	Field       string   `dynamodbav:"Field"`       // Field name (e.g., "loancode"), or "Table.field" to scope it (e.g., "LoanCashFlow.loancode")
+	IncludeList []string `dynamodbav:"IncludeList"` // Value patterns to include (empty means all allowed)
+	ExcludeList []string `dynamodbav:"ExcludeList"` // Value patterns to exclude (takes precedence over include)
 	FilterType  string   `dynamodbav:"FilterType"`  // "include" or "exclude" for primary behavior
+	Validity             // When the filter applies (always, if unset)
+
+	// AllOf and AnyOf hold the filters a merge combined: a value must also pass every filter
+	// of AllOf and, if set, one of AnyOf. Merged filters exist only in memory.
+	AllOf []FieldFilter `dynamodbav:"-"`
+	AnyOf []FieldFilter `dynamodbav:"-"`
 }
 
+// FieldFilterMergeStrategy is how a group's filter on a field combines with the other
+// filters on that field a user gets from their groups and their own record
+type FieldFilterMergeStrategy string
+
+const (
+	MergeStrategyUnion           FieldFilterMergeStrategy = "union"            // A value passes if any filter allows it (the default)
+	MergeStrategyIntersection    FieldFilterMergeStrategy = "intersection"     // A value passes if every filter allows it
+	MergeStrategyMostRestrictive FieldFilterMergeStrategy = "most_restrictive" // Only the most restrictive filter applies
+)
+
 // PermissionAction defines the allowed actions
 type PermissionAction string
 
@@ -33,17 +61,24 @@ const (
 	ActionBlocking  PermissionAction = "blocking" // Explicitly blocks access
 )
 
+// IsValid reports whether a is a known action
+func (a PermissionAction) IsValid() bool {
+	return a == ActionRead || a == ActionWrite || a == ActionReadWrite || a == ActionBlocking
+}
+
 // Permission represents a specific permission level
 type Permission struct {
 	Table   string           // Table name (e.g., "LoanCache")
-	Columns []string         // Column names (for future use, now table-level only)
+	Columns []string         // Column names, stored as "Table#column" keys (empty means the whole table, "Table#*")
 	Action  PermissionAction // Action allowed
 }
 
 // CacheEntry represents a cached ACL record with TTL
 type CacheEntry struct {
-	ACL       *ACLRecord
-	ExpiresAt time.Time
+	ACL        *ACLRecord
+	CachedAt   time.Time
+	ExpiresAt  time.Time
+	NextChange time.Time // When a time-bound grant of the cached ACL next starts or ends
 }
 
 // IsExpired checks if the cache entry has expired
@@ -51,12 +86,20 @@ func (c *CacheEntry) IsExpired() bool {
 	return time.Now().After(c.ExpiresAt)
 }
 
-// NewCacheEntry creates a new cache entry with TTL
-func NewCacheEntry(acl *ACLRecord, ttl time.Duration) *CacheEntry {
-	return &CacheEntry{
-		ACL:       acl,
-		ExpiresAt: time.Now().Add(ttl),
+// NewCacheEntry creates a new cache entry with TTL. It expires early at nextChange, if set,
+// so time-bound grants start and end on time.
+func NewCacheEntry(acl *ACLRecord, ttl time.Duration, nextChange time.Time) *CacheEntry {
+	now := time.Now()
+	entry := &CacheEntry{
+		ACL:        acl,
+		CachedAt:   now,
+		ExpiresAt:  now.Add(ttl),
+		NextChange: nextChange,
+	}
+	if !nextChange.IsZero() && nextChange.Before(entry.ExpiresAt) {
+		entry.ExpiresAt = nextChange
 	}
+	return entry
 }
 
 // MergedACL represents the final ACL after merging user and group permissions
@@ -64,8 +107,9 @@ type MergedACL struct {
 	UserEmail    string                 // Original user email
 	Permissions  map[string]string      // All merged permissions
 	FieldFilters map[string]FieldFilter // Merged field filters
-	Groups       []string               // User's groups
+	Groups       []string               // User's direct groups, not the ones they inherit from
 	CachedAt     time.Time              // When this was cached
+	NextChange   time.Time              // When a time-bound grant next starts or ends (zero if none)
 }
 
 // ColumnPermissions represents column-level access control
@@ -136,6 +180,19 @@ func (m *MergedACL) CanAccess(table, action string) bool {
 	return false
 }
 
+// CanAccessColumn checks if the merged ACL allows an action on one column of a table.
+// The most specific rule decides: "Table#column" first, then the table-level rules of CanAccess,
+// so a role can be blocked from "LoanCashFlow#leverageInterest" while reading the rest of the
+// table, or read a single column of a table it is otherwise blocked from.
+func (m *MergedACL) CanAccessColumn(table, column, action string) bool {
+	if column != "" && column != "*" {
+		if perm, exists := m.Permissions[table+"#"+column]; exists {
+			return hasPermission(perm, action)
+		}
+	}
+	return m.CanAccess(table, action)
+}
+
 // hasPermission checks if a permission string allows the requested action
 func hasPermission(permission, action string) bool {
 	switch action {
@@ -150,48 +207,222 @@ func hasPermission(permission, action string) bool {
 	}
 }
 
-// IsValueAllowed checks if a specific field value passes the field filter rules
+// IsValueAllowed checks if a specific field value passes the field filter rules.
+// List values are patterns (see ParseValuePattern); a malformed one excludes every value
+// from an exclude list and includes none from an include list.
 func (ff *FieldFilter) IsValueAllowed(value string) bool {
-	// If exclude list contains the value, it's blocked
+	// If exclude list matches the value, it's blocked
 	for _, excludeVal := range ff.ExcludeList {
-		if excludeVal == value {
+		if matchesPattern(excludeVal, value, true) {
 			return false
 		}
 	}
 
-	// If include list is empty, all values are allowed (unless excluded above)
-	if len(ff.IncludeList) == 0 {
-		return true
+	// If include list exists, value must match one of its entries
+	if len(ff.IncludeList) > 0 && !slices.ContainsFunc(ff.IncludeList, func(includeVal string) bool {
+		return matchesPattern(includeVal, value, false)
+	}) {
+		return false
 	}
 
-	// If include list exists, value must be in it
-	for _, includeVal := range ff.IncludeList {
-		if includeVal == value {
-			return true
+	// Merged filters must also pass the filters they combine
+	for _, filter := range ff.AllOf {
+		if !filter.IsValueAllowed(value) {
+			return false
 		}
 	}
+	if len(ff.AnyOf) > 0 && !slices.ContainsFunc(ff.AnyOf, func(filter FieldFilter) bool {
+		return filter.IsValueAllowed(value)
+	}) {
+		return false
+	}
 
-	// Value not in include list
-	return false
+	return true
 }
 
-// MergeFieldFilters combines user and group field filters, with user taking priority
-func MergeFieldFilters(userFilters, groupFilters map[string]FieldFilter) map[string]FieldFilter {
-	merged := make(map[string]FieldFilter)
+// MergeFieldFilters combines a user's field filters with those of their groups, given level
+// by level, nearest first: the user's own groups, then the groups they inherit from, and so on.
+//
+// A key filtered at a nearer level hides that key's filters and strategies at farther ones.
+// Group filters on the same key at one level combine by the strategy the groups store for it;
+// if groups disagree, the strictest wins (most_restrictive, then intersection, then union).
+// Groups are applied in PrincipalID order, so the result never depends on the order they were
+// read in.
+//
+// When the groups set a strategy for a key, the user's filter on it combines with theirs the
+// same way. Otherwise the user filter replaces the group filters on its field at the same or a
+// narrower scope: a global user filter on "loancode" also replaces a group's
+// "LoanCashFlow.loancode", while a user's "LoanCashFlow.loancode" leaves a group's global
+// "loancode" in force, so on LoanCashFlow the two both apply.
+func MergeFieldFilters(userFilters map[string]FieldFilter, groupLevels [][]*ACLRecord) map[string]FieldFilter {
+	strategies := make(map[string]FieldFilterMergeStrategy)
+	groupFilters := make(map[string][]FieldFilter)
+	for _, level := range groupLevels {
+		groups := slices.Clone(level)
+		slices.SortFunc(groups, func(a, b *ACLRecord) int {
+			return strings.Compare(a.PrincipalID, b.PrincipalID)
+		})
+
+		levelFilters := make(map[string][]FieldFilter)
+		for _, group := range groups {
+			for key, strategy := range group.MergeStrategies {
+				if _, hidden := groupFilters[key]; !hidden && strategy.strictness() > strategies[key].strictness() {
+					strategies[key] = strategy
+				}
+			}
+			for key, filter := range group.FieldFilters {
+				if _, hidden := groupFilters[key]; !hidden {
+					levelFilters[key] = append(levelFilters[key], filter)
+				}
+			}
+		}
+		maps.Copy(groupFilters, levelFilters)
+	}
 
-	// Start with group filters
-	for field, filter := range groupFilters {
-		merged[field] = filter
+	merged := make(map[string]FieldFilter)
+	for key, filters := range groupFilters {
+		strategy, hasStrategy := strategies[key]
+		if userFilter, exists := userFilters[key]; exists && hasStrategy {
+			filters = append(filters, userFilter)
+		} else if overridesFieldFilter(userFilters, key) {
+			continue
+		}
+		merged[key] = combineFieldFilters(key, strategy, filters)
 	}
 
-	// User filters override group filters
-	for field, filter := range userFilters {
-		merged[field] = filter
+	// User filters no group strategy applies to override group filters
+	for key, filter := range userFilters {
+		if _, exists := merged[key]; !exists {
+			merged[key] = filter
+		}
 	}
 
 	return merged
 }
 
+// overridesFieldFilter reports whether the user's filters replace a group filter stored under key
+func overridesFieldFilter(userFilters map[string]FieldFilter, key string) bool {
+	if _, exists := userFilters[key]; exists {
+		return true
+	}
+	table, field := ParseFieldFilterKey(key)
+	_, exists := userFilters[field]
+	return exists && table != ""
+}
+
+// combineFieldFilters merges the filters on one key by strategy, in the order given
+func combineFieldFilters(key string, strategy FieldFilterMergeStrategy, filters []FieldFilter) FieldFilter {
+	if len(filters) == 1 {
+		return filters[0]
+	}
+
+	switch strategy {
+	case MergeStrategyIntersection:
+		return FieldFilter{Field: key, AllOf: filters}
+	case MergeStrategyMostRestrictive:
+		// A pattern can match any number of values, so list sizes only rank filters of exact
+		// values; others are intersected, which is at least as restrictive as each of them
+		if !slices.ContainsFunc(filters, func(filter FieldFilter) bool { return !hasOnlyExactValues(filter) }) {
+			// The first of equally restrictive filters wins
+			return slices.MinFunc(filters, compareRestrictiveness)
+		}
+		return FieldFilter{Field: key, AllOf: filters}
+	default:
+		return FieldFilter{Field: key, AnyOf: filters}
+	}
+}
+
+// hasOnlyExactValues reports whether a stored filter's include and exclude entries are all
+// exact values, so their counts measure how many values it allows
+func hasOnlyExactValues(filter FieldFilter) bool {
+	if len(filter.AllOf) > 0 || len(filter.AnyOf) > 0 {
+		return false
+	}
+	for _, value := range slices.Concat(filter.IncludeList, filter.ExcludeList) {
+		if pattern, err := ParseValuePattern(value); err != nil || pattern.Kind != PatternExact {
+			return false
+		}
+	}
+	return true
+}
+
+// compareRestrictiveness orders the more restrictive of two stored filters of exact values
+// first: fewer include entries, then more exclude entries
+func compareRestrictiveness(a, b FieldFilter) int {
+	// An empty include list allows every value, so it ranks after any non-empty one
+	includeRank := func(filter FieldFilter) int {
+		if len(filter.IncludeList) == 0 {
+			return math.MaxInt
+		}
+		return len(filter.IncludeList)
+	}
+	if c := cmp.Compare(includeRank(a), includeRank(b)); c != 0 {
+		return c
+	}
+	return cmp.Compare(len(b.ExcludeList), len(a.ExcludeList))
+}
+
+// strictness ranks strategies for resolving disagreements between groups; unknown strategies rank lowest
+func (s FieldFilterMergeStrategy) strictness() int {
+	switch s {
+	case MergeStrategyUnion:
+		return 1
+	case MergeStrategyIntersection:
+		return 2
+	case MergeStrategyMostRestrictive:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// IsValid reports whether s is a known merge strategy
+func (s FieldFilterMergeStrategy) IsValid() bool {
+	return s.strictness() > 0
+}
+
+// fieldFilterScopeSeparator separates the table from the field in a scoped field filter key
+const fieldFilterScopeSeparator = "."
+
+// FieldFilterKey returns the key a filter on a field is stored under: "Table.field" when
+// scoped to a table, or just the field when table is empty and the filter is global
+func FieldFilterKey(table, field string) string {
+	if table == "" {
+		return field
+	}
+	return table + fieldFilterScopeSeparator + field
+}
+
+// ParseFieldFilterKey splits a field filter key into its table, empty for a global filter,
+// and field
+func ParseFieldFilterKey(key string) (table, field string) {
+	if table, field, found := strings.Cut(key, fieldFilterScopeSeparator); found {
+		return table, field
+	}
+	return "", key
+}
+
+// FieldFiltersForTable resolves the filters that apply to a table's columns, keyed by field.
+// A filter scoped to the table only narrows a global one on the same field: a value must pass
+// both. Filters scoped to other tables are left out.
+func FieldFiltersForTable(fieldFilters map[string]FieldFilter, table string) map[string]FieldFilter {
+	resolved := make(map[string]FieldFilter, len(fieldFilters))
+	for key, filter := range fieldFilters {
+		if filterTable, field := ParseFieldFilterKey(key); filterTable == "" {
+			if scoped, exists := fieldFilters[FieldFilterKey(table, field)]; exists {
+				resolved[field] = FieldFilter{Field: field, AllOf: []FieldFilter{filter, scoped}}
+			} else {
+				resolved[field] = filter
+			}
+		} else if filterTable == table {
+			if _, global := fieldFilters[field]; !global {
+				resolved[field] = filter
+			}
+		}
+	}
+	return resolved
+}
+
 // FilterArrayByField filters an array of items based on field filter rules
 func FilterArrayByField(items []any, fieldName string, fieldFilters map[string]FieldFilter, getFieldValue func(any) string) []any {
 	// If no filter exists for this field, return all items
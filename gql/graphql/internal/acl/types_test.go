@@ -0,0 +1,121 @@
+package acl
+
+import "testing"
+
+func TestMostRestrictiveMerge(t *testing.T) {
+	groups := func(filters ...FieldFilter) [][]*ACLRecord {
+		level := make([]*ACLRecord, len(filters))
+		for i, filter := range filters {
+			level[i] = &ACLRecord{
+				PrincipalID:     "group:" + string(rune('a'+i)),
+				FieldFilters:    map[string]FieldFilter{"loancode": filter},
+				MergeStrategies: map[string]FieldFilterMergeStrategy{"loancode": MergeStrategyMostRestrictive},
+			}
+		}
+		return [][]*ACLRecord{level}
+	}
+
+	tests := []struct {
+		name    string
+		filters []FieldFilter
+		allowed map[string]bool
+	}{
+		{
+			name: "exact values rank by include count",
+			filters: []FieldFilter{
+				{IncludeList: []string{"L1", "L2"}},
+				{IncludeList: []string{"L1"}},
+			},
+			allowed: map[string]bool{"L1": true, "L2": false},
+		},
+		{
+			name: "a single prefix is not narrower than two exact values",
+			filters: []FieldFilter{
+				{IncludeList: []string{"L1", "M1"}},
+				{IncludeList: []string{"L*"}},
+			},
+			allowed: map[string]bool{"L1": true, "L2": false, "M1": false},
+		},
+		{
+			name: "exclude patterns are intersected too",
+			filters: []FieldFilter{
+				{ExcludeList: []string{"L1"}},
+				{ExcludeList: []string{"M*"}},
+			},
+			allowed: map[string]bool{"L1": false, "M1": false, "N1": true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged := MergeFieldFilters(nil, groups(tt.filters...))["loancode"]
+			for value, want := range tt.allowed {
+				if got := merged.IsValueAllowed(value); got != want {
+					t.Errorf("IsValueAllowed(%q) = %v, want %v", value, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestMergeFieldFiltersDepthPrecedence(t *testing.T) {
+	parent := &ACLRecord{
+		PrincipalID: "group:parent",
+		FieldFilters: map[string]FieldFilter{
+			"loancode":     {IncludeList: []string{"L1", "L2"}},
+			"propertycode": {IncludeList: []string{"P1"}},
+		},
+		MergeStrategies: map[string]FieldFilterMergeStrategy{"loancode": MergeStrategyIntersection},
+	}
+	child := &ACLRecord{
+		PrincipalID:  "group:child",
+		FieldFilters: map[string]FieldFilter{"loancode": {IncludeList: []string{"L3"}}},
+	}
+
+	merged := MergeFieldFilters(nil, [][]*ACLRecord{{child}, {parent}})
+
+	loanCode := merged["loancode"]
+	if !loanCode.IsValueAllowed("L3") || loanCode.IsValueAllowed("L1") {
+		t.Errorf("loancode = %+v, want only the child's filter", loanCode)
+	}
+	propertyCode := merged["propertycode"]
+	if !propertyCode.IsValueAllowed("P1") || propertyCode.IsValueAllowed("P2") {
+		t.Errorf("propertycode = %+v, want the parent's filter", propertyCode)
+	}
+}
+
+func TestScopedFilterNarrowsGlobal(t *testing.T) {
+	levels := [][]*ACLRecord{{
+		{
+			PrincipalID:  "group:a",
+			FieldFilters: map[string]FieldFilter{"LoanCashFlow.loancode": {IncludeList: []string{"L1", "L2"}}},
+		},
+		{
+			PrincipalID:  "group:b",
+			FieldFilters: map[string]FieldFilter{"loancode": {IncludeList: []string{"L2", "L3"}}},
+		},
+	}}
+	merged := MergeFieldFilters(nil, levels)
+
+	tests := []struct {
+		table   string
+		allowed map[string]bool
+	}{
+		{table: "LoanCashFlow", allowed: map[string]bool{"L1": false, "L2": true, "L3": false}},
+		{table: "LoanInfo", allowed: map[string]bool{"L1": false, "L2": true, "L3": true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.table, func(t *testing.T) {
+			filter, exists := FieldFiltersForTable(merged, tt.table)["loancode"]
+			if !exists {
+				t.Fatal("no loancode filter applies")
+			}
+			for value, want := range tt.allowed {
+				if got := filter.IsValueAllowed(value); got != want {
+					t.Errorf("IsValueAllowed(%q) = %v, want %v", value, got, want)
+				}
+			}
+		})
+	}
+}
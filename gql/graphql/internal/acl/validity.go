@@ -0,0 +1,121 @@
+package acl
+
+import (
+	"maps"
+	"slices"
+	"time"
+)
+
+// Validity bounds when a grant applies. A zero ValidFrom or ValidUntil leaves that side open.
+type Validity struct {
+	ValidFrom  time.Time `dynamodbav:"ValidFrom"`  // First moment the grant applies
+	ValidUntil time.Time `dynamodbav:"ValidUntil"` // First moment the grant no longer applies
+
+	// parseErr is why a stored bound could not be read; the window then never applies
+	parseErr error
+}
+
+// GrantKind names the kind of grant a validity window is attached to
+type GrantKind string
+
+const (
+	GrantKindPermission  GrantKind = "permission"
+	GrantKindGroup       GrantKind = "group"
+	GrantKindFieldFilter GrantKind = "field_filter"
+)
+
+// ExpiringGrant is a grant whose validity ends within a requested window
+type ExpiringGrant struct {
+	PrincipalID string
+	Kind        GrantKind
+	Name        string // Permission key, group name or field filter key
+	ValidUntil  time.Time
+}
+
+// ActiveAt reports whether the grant applies at t
+func (v Validity) ActiveAt(t time.Time) bool {
+	return (v.ValidFrom.IsZero() || !t.Before(v.ValidFrom)) &&
+		(v.ValidUntil.IsZero() || t.Before(v.ValidUntil))
+}
+
+// nextBoundary returns the earlier of next and the first of the grant's bounds after t,
+// ignoring zero times
+func (v Validity) nextBoundary(t, next time.Time) time.Time {
+	for _, bound := range []time.Time{v.ValidFrom, v.ValidUntil} {
+		if bound.After(t) && (next.IsZero() || bound.Before(next)) {
+			next = bound
+		}
+	}
+	return next
+}
+
+// ActiveAt returns a copy of the record holding only the permissions, group memberships and
+// field filters in force at t. Grants without a validity window always apply. Restrictions
+// (field filters and blocking permissions) whose window cannot be read are kept, so a corrupt
+// window never widens access.
+func (r *ACLRecord) ActiveAt(t time.Time) *ACLRecord {
+	active := *r
+
+	active.Permissions = maps.Clone(r.Permissions)
+	maps.DeleteFunc(active.Permissions, func(key, permission string) bool {
+		validity := r.PermissionValidity[key]
+		if validity.parseErr != nil && permission == string(ActionBlocking) {
+			return false
+		}
+		return !validity.ActiveAt(t)
+	})
+
+	active.Groups = slices.DeleteFunc(slices.Clone(r.Groups), func(group string) bool {
+		return !r.GroupValidity[group].ActiveAt(t)
+	})
+
+	active.FieldFilters = maps.Clone(r.FieldFilters)
+	maps.DeleteFunc(active.FieldFilters, func(_ string, filter FieldFilter) bool {
+		return filter.parseErr == nil && !filter.ActiveAt(t)
+	})
+
+	return &active
+}
+
+// NextBoundary returns the first moment after t at which one of the record's grants starts or
+// ends, or the zero time if none does
+func (r *ACLRecord) NextBoundary(t time.Time) time.Time {
+	var next time.Time
+	for _, validity := range r.PermissionValidity {
+		next = validity.nextBoundary(t, next)
+	}
+	for _, validity := range r.GroupValidity {
+		next = validity.nextBoundary(t, next)
+	}
+	for _, filter := range r.FieldFilters {
+		next = filter.nextBoundary(t, next)
+	}
+	return next
+}
+
+// ExpiringGrants returns the record's grants that apply at t and end before deadline
+func (r *ACLRecord) ExpiringGrants(t, deadline time.Time) []ExpiringGrant {
+	var expiring []ExpiringGrant
+	add := func(kind GrantKind, name string, validity Validity) {
+		if validity.ActiveAt(t) && !validity.ValidUntil.IsZero() && validity.ValidUntil.Before(deadline) {
+			expiring = append(expiring, ExpiringGrant{
+				PrincipalID: r.PrincipalID,
+				Kind:        kind,
+				Name:        name,
+				ValidUntil:  validity.ValidUntil,
+			})
+		}
+	}
+
+	for key := range r.Permissions {
+		add(GrantKindPermission, key, r.PermissionValidity[key])
+	}
+	for _, group := range r.Groups {
+		add(GrantKindGroup, group, r.GroupValidity[group])
+	}
+	for key, filter := range r.FieldFilters {
+		add(GrantKindFieldFilter, key, filter.Validity)
+	}
+
+	return expiring
+}
@@ -0,0 +1,76 @@
+package acl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+func TestActiveAtKeepsRestrictionsWithUnreadableValidity(t *testing.T) {
+	unreadable := unmarshalValidity(map[string]types.AttributeValue{
+		"ValidUntil": &types.AttributeValueMemberS{Value: "not a time"},
+	})
+	if unreadable.parseErr == nil {
+		t.Fatal("unmarshalValidity did not record the parse error")
+	}
+
+	record := &ACLRecord{
+		PrincipalID: "user@example.com",
+		Groups:      []string{"group:analysts"},
+		Permissions: map[string]string{
+			"LoanCashFlow":          "read",
+			"LoanCashFlow#sbalance": "blocking",
+			"LoanInfo":              "read",
+			"LoanInfo#propertyname": "blocking",
+		},
+		FieldFilters: map[string]FieldFilter{
+			"loancode": {Field: "loancode", IncludeList: []string{"L1"}, Validity: unreadable},
+		},
+		PermissionValidity: map[string]Validity{
+			"LoanCashFlow":          unreadable,
+			"LoanCashFlow#sbalance": unreadable,
+		},
+		GroupValidity: map[string]Validity{"group:analysts": unreadable},
+	}
+
+	active := record.ActiveAt(time.Now())
+
+	t.Run("field filter is kept", func(t *testing.T) {
+		if _, ok := active.FieldFilters["loancode"]; !ok {
+			t.Error("field filter with an unreadable window was dropped")
+		}
+	})
+	t.Run("blocking permission is kept", func(t *testing.T) {
+		if active.Permissions["LoanCashFlow#sbalance"] != "blocking" {
+			t.Error("blocking permission with an unreadable window was dropped")
+		}
+	})
+	t.Run("grants are dropped", func(t *testing.T) {
+		if _, ok := active.Permissions["LoanCashFlow"]; ok {
+			t.Error("read permission with an unreadable window was kept")
+		}
+		if len(active.Groups) != 0 {
+			t.Errorf("group membership with an unreadable window was kept: %v", active.Groups)
+		}
+	})
+	t.Run("grants without a window are unaffected", func(t *testing.T) {
+		if active.Permissions["LoanInfo"] != "read" || active.Permissions["LoanInfo#propertyname"] != "blocking" {
+			t.Errorf("permissions without a window changed: %v", active.Permissions)
+		}
+	})
+}
+
+func TestActiveAtDropsExpiredRestrictions(t *testing.T) {
+	expired := Validity{ValidUntil: time.Now().Add(-time.Hour)}
+	record := &ACLRecord{
+		Permissions:        map[string]string{"LoanCashFlow#sbalance": "blocking"},
+		FieldFilters:       map[string]FieldFilter{"loancode": {IncludeList: []string{"L1"}, Validity: expired}},
+		PermissionValidity: map[string]Validity{"LoanCashFlow#sbalance": expired},
+	}
+
+	active := record.ActiveAt(time.Now())
+	if len(active.Permissions) != 0 || len(active.FieldFilters) != 0 {
+		t.Errorf("expired restrictions were kept: %v, %v", active.Permissions, active.FieldFilters)
+	}
+}
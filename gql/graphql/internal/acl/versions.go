@@ -0,0 +1,59 @@
+package acl
+
+import (
+	"fmt"
+	"time"
+)
+
+// ACLVersion is one numbered state of a principal's ACL record. Every audited change stores
+// the record it leaves behind as the next version, starting at 1; version 0 holds a record as
+// it was before versioning began, saved on its first change.
+type ACLVersion struct {
+	PrincipalID string
+	Version     int
+	Record      *ACLRecord // nil if the change deleted the record
+	Actor       string     // Empty for version 0
+	Operation   AuditOperation
+	CreatedAt   time.Time
+}
+
+// Deleted reports whether the version records the deletion of the record
+func (v *ACLVersion) Deleted() bool {
+	return v.Record == nil
+}
+
+// Precondition is the state of a record a change is based on. The change is only written if
+// the record is still in that state; unset fields are not checked.
+type Precondition struct {
+	Version   *int   // The record's version
+	UpdatedAt string // The record's last update timestamp
+	Absent    bool   // The record does not exist
+}
+
+// matches reports whether a record, which may not exist, is in the state
+func (p *Precondition) matches(record *ACLRecord, found bool) bool {
+	if p.Absent {
+		return !found
+	}
+	if p.Version != nil && (!found || record.Version != *p.Version) {
+		return false
+	}
+	if p.UpdatedAt != "" && (!found || record.UpdatedAt != p.UpdatedAt) {
+		return false
+	}
+	return true
+}
+
+// ConflictError reports that a record changed since the state a change was based on
+type ConflictError struct {
+	PrincipalID string
+	Current     *ACLRecord // The record as it is now, or nil if it no longer exists
+}
+
+func (e *ConflictError) Error() string {
+	if e.Current == nil {
+		return fmt.Sprintf("ACL record for %s was changed by someone else: it no longer exists", e.PrincipalID)
+	}
+	return fmt.Sprintf("ACL record for %s was changed by someone else: it is now at version %d, updated at %s",
+		e.PrincipalID, e.Current.Version, e.Current.UpdatedAt)
+}
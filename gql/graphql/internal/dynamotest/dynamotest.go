@@ -0,0 +1,212 @@
+// Package dynamotest serves a scripted DynamoDB endpoint so tests can drive the real SDK client
+package dynamotest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+)
+
+// Item is a DynamoDB item in its JSON wire form, e.g. {"loancode": {"S": "L1"}}
+type Item = map[string]any
+
+// HandlerFunc answers one DynamoDB operation. input is the decoded JSON request body and
+// the returned value is encoded as the response body.
+type HandlerFunc func(input map[string]any) (any, error)
+
+// Error fails a call with a DynamoDB error type such as ConditionalCheckFailedException.
+// Fields are added to the error body, e.g. CancellationReasons.
+type Error struct {
+	Type    string
+	Message string
+	Fields  map[string]any
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Type, e.Message)
+}
+
+// Server is a DynamoDB endpoint that answers each operation with a registered handler
+type Server struct {
+	t        testing.TB
+	server   *httptest.Server
+	mu       sync.Mutex
+	handlers map[string]HandlerFunc
+	requests map[string][]map[string]any
+}
+
+// NewServer starts a server that is closed when the test ends
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		t:        t,
+		handlers: make(map[string]HandlerFunc),
+		requests: make(map[string][]map[string]any),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.server.Close)
+
+	return s
+}
+
+// Handle registers the handler for an operation such as "Query" or "TransactWriteItems"
+func (s *Server) Handle(operation string, handler HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[operation] = handler
+}
+
+// Requests returns the inputs the operation was called with, in arrival order
+func (s *Server) Requests(operation string) []map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]map[string]any(nil), s.requests[operation]...)
+}
+
+// Client returns a DynamoDB client that talks to the server
+func (s *Server) Client() *dynamodb.Client {
+	return dynamodb.New(dynamodb.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(s.server.URL),
+		Credentials:  aws.AnonymousCredentials{},
+		HTTPClient:   s.server.Client(),
+		Retryer:      aws.NopRetryer{},
+	})
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	target := r.Header.Get("X-Amz-Target")
+	operation := target[strings.LastIndex(target, ".")+1:]
+
+	var input map[string]any
+	body, err := io.ReadAll(r.Body)
+	if err == nil {
+		err = json.Unmarshal(body, &input)
+	}
+	if err != nil {
+		s.t.Errorf("dynamotest: failed to read %s request: %v", operation, err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	handler := s.handlers[operation]
+	s.requests[operation] = append(s.requests[operation], input)
+	s.mu.Unlock()
+
+	if handler == nil {
+		s.t.Errorf("dynamotest: unexpected %s request", operation)
+		writeError(w, &Error{Type: "ValidationException", Message: "no handler for " + operation})
+		return
+	}
+
+	output, err := handler(input)
+	if err != nil {
+		dynamoErr, ok := err.(*Error)
+		if !ok {
+			dynamoErr = &Error{Type: "InternalServerError", Message: err.Error()}
+		}
+		writeError(w, dynamoErr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+	if err := json.NewEncoder(w).Encode(output); err != nil {
+		s.t.Errorf("dynamotest: failed to write %s response: %v", operation, err)
+	}
+}
+
+func writeError(w http.ResponseWriter, e *Error) {
+	body := map[string]any{
+		"__type":  "com.amazonaws.dynamodb.v20120810#" + e.Type,
+		"message": e.Message,
+	}
+	for name, value := range e.Fields {
+		body[name] = value
+	}
+
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// S is a string attribute value
+func S(value string) map[string]any {
+	return map[string]any{"S": value}
+}
+
+// N is a number attribute value
+func N(value string) map[string]any {
+	return map[string]any{"N": value}
+}
+
+// Page answers a Query or Scan from items, honouring Limit and ExclusiveStartKey.
+// keyAttributes name the attributes that make up LastEvaluatedKey.
+func Page(items []Item, input map[string]any, keyAttributes ...string) map[string]any {
+	start := 0
+	if startKey, ok := input["ExclusiveStartKey"].(map[string]any); ok {
+		start = len(items)
+		for i, item := range items {
+			if sameKey(item, startKey, keyAttributes) {
+				start = i + 1
+				break
+			}
+		}
+	}
+
+	end := len(items)
+	if limit, ok := input["Limit"].(float64); ok && start+int(limit) < end {
+		end = start + int(limit)
+	}
+
+	page := append([]Item{}, items[start:end]...)
+	output := map[string]any{
+		"Items":        page,
+		"Count":        len(page),
+		"ScannedCount": len(page),
+	}
+	if end < len(items) {
+		lastKey := make(map[string]any, len(keyAttributes))
+		for _, name := range keyAttributes {
+			lastKey[name] = items[end-1][name]
+		}
+		output["LastEvaluatedKey"] = lastKey
+	}
+
+	return output
+}
+
+func sameKey(item Item, key map[string]any, keyAttributes []string) bool {
+	for _, name := range keyAttributes {
+		a, _ := json.Marshal(item[name])
+		b, _ := json.Marshal(key[name])
+		if string(a) != string(b) {
+			return false
+		}
+	}
+	return true
+}
+
+// StringValues returns the string values in a request's ExpressionAttributeValues
+func StringValues(input map[string]any) []string {
+	values, _ := input["ExpressionAttributeValues"].(map[string]any)
+
+	var found []string
+	for _, value := range values {
+		if attribute, ok := value.(map[string]any); ok {
+			if s, ok := attribute["S"].(string); ok {
+				found = append(found, s)
+			}
+		}
+	}
+	return found
+}
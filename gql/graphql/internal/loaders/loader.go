@@ -0,0 +1,116 @@
+package loaders
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Loader collects the keys requested while a GraphQL response is being resolved and reads them
+// with one fetch per batch. Results are cached for the rest of the request, so a key is read
+// at most once however many fields ask for it.
+type Loader[K comparable, V any] struct {
+	ctx      context.Context
+	fetch    func(ctx context.Context, keys []K) (map[K]V, error)
+	wait     time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	batches map[K]*batch[K, V]
+	current *batch[K, V]
+}
+
+// batch is one fetch; done closes once results or err are set
+type batch[K comparable, V any] struct {
+	keys     []K
+	dispatch sync.Once
+	done     chan struct{}
+	results  map[K]V
+	err      error
+}
+
+// NewLoader creates a loader whose fetches run under ctx, the request context. A batch is
+// fetched once it holds maxBatch keys or wait has passed since its first key was requested.
+func NewLoader[K comparable, V any](ctx context.Context, wait time.Duration, maxBatch int, fetch func(ctx context.Context, keys []K) (map[K]V, error)) *Loader[K, V] {
+	return &Loader[K, V]{
+		ctx:      ctx,
+		fetch:    fetch,
+		wait:     wait,
+		maxBatch: maxBatch,
+		batches:  make(map[K]*batch[K, V]),
+	}
+}
+
+// Load returns the value for key, or the zero value if the fetch did not return it
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	b := l.enqueue(key)
+
+	select {
+	case <-b.done:
+		return b.results[key], b.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// LoadMany returns the values for keys in the same order; keys the fetch did not return get the zero value
+func (l *Loader[K, V]) LoadMany(ctx context.Context, keys []K) ([]V, error) {
+	batches := make([]*batch[K, V], len(keys))
+	for i, key := range keys {
+		batches[i] = l.enqueue(key)
+	}
+
+	values := make([]V, len(keys))
+	for i, b := range batches {
+		select {
+		case <-b.done:
+			if b.err != nil {
+				return nil, b.err
+			}
+			values[i] = b.results[keys[i]]
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return values, nil
+}
+
+// enqueue returns the batch that reads key, adding key to the open batch if no batch has it yet
+func (l *Loader[K, V]) enqueue(key K) *batch[K, V] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if b, exists := l.batches[key]; exists {
+		return b
+	}
+
+	b := l.current
+	if b == nil {
+		b = &batch[K, V]{done: make(chan struct{})}
+		l.current = b
+		time.AfterFunc(l.wait, func() { l.dispatch(b) })
+	}
+	b.keys = append(b.keys, key)
+	l.batches[key] = b
+
+	if len(b.keys) >= l.maxBatch {
+		l.current = nil
+		go l.dispatch(b)
+	}
+	return b
+}
+
+// dispatch fetches a batch exactly once, whichever of the timer and the size limit comes first
+func (l *Loader[K, V]) dispatch(b *batch[K, V]) {
+	b.dispatch.Do(func() {
+		l.mu.Lock()
+		if l.current == b {
+			l.current = nil
+		}
+		l.mu.Unlock()
+
+		b.results, b.err = l.fetch(l.ctx, b.keys)
+		close(b.done)
+	})
+}
@@ -0,0 +1,94 @@
+package loaders
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingFetch returns each key's length and records the batches it was called with
+type recordingFetch struct {
+	mu      sync.Mutex
+	batches [][]string
+	err     error
+}
+
+func (f *recordingFetch) fetch(ctx context.Context, keys []string) (map[string]int, error) {
+	f.mu.Lock()
+	f.batches = append(f.batches, slices.Clone(keys))
+	f.mu.Unlock()
+
+	if f.err != nil {
+		return nil, f.err
+	}
+	results := make(map[string]int, len(keys))
+	for _, key := range keys {
+		if key != "missing" {
+			results[key] = len(key)
+		}
+	}
+	return results, nil
+}
+
+func TestLoaderBatchesConcurrentLoads(t *testing.T) {
+	fetch := &recordingFetch{}
+	loader := NewLoader(context.Background(), 20*time.Millisecond, 100, fetch.fetch)
+
+	keys := []string{"a", "bb", "ccc", "bb", "missing"}
+	values := make([]int, len(keys))
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Go(func() {
+			value, err := loader.Load(context.Background(), key)
+			if err != nil {
+				t.Errorf("Load(%q): %v", key, err)
+			}
+			values[i] = value
+		})
+	}
+	wg.Wait()
+
+	if !slices.Equal(values, []int{1, 2, 3, 2, 0}) {
+		t.Errorf("values = %v, want [1 2 3 2 0]", values)
+	}
+	if len(fetch.batches) != 1 || len(fetch.batches[0]) != 4 {
+		t.Errorf("batches = %v, want one batch of the 4 distinct keys", fetch.batches)
+	}
+
+	// Loaded keys are cached for the rest of the request
+	if _, err := loader.Load(context.Background(), "a"); err != nil || len(fetch.batches) != 1 {
+		t.Errorf("reloading a key fetched again: batches = %v, err = %v", fetch.batches, err)
+	}
+}
+
+func TestLoaderSplitsAtMaxBatch(t *testing.T) {
+	fetch := &recordingFetch{}
+	loader := NewLoader(context.Background(), time.Hour, 2, fetch.fetch)
+
+	values, err := loader.LoadMany(context.Background(), []string{"a", "bb", "ccc", "dddd"})
+	if err != nil {
+		t.Fatalf("LoadMany: %v", err)
+	}
+	if !slices.Equal(values, []int{1, 2, 3, 4}) {
+		t.Errorf("values = %v, want [1 2 3 4]", values)
+	}
+	if len(fetch.batches) != 2 {
+		t.Errorf("batches = %v, want two full batches without waiting", fetch.batches)
+	}
+}
+
+func TestLoaderFailsEveryKeyOfBatch(t *testing.T) {
+	fetchErr := errors.New("read failed")
+	fetch := &recordingFetch{err: fetchErr}
+	loader := NewLoader(context.Background(), time.Millisecond, 100, fetch.fetch)
+
+	if _, err := loader.LoadMany(context.Background(), []string{"a", "bb"}); !errors.Is(err, fetchErr) {
+		t.Errorf("LoadMany() error = %v, want %v", err, fetchErr)
+	}
+	if _, err := loader.Load(context.Background(), "bb"); !errors.Is(err, fetchErr) {
+		t.Errorf("Load() of a key in the failed batch: error = %v, want %v", err, fetchErr)
+	}
+}
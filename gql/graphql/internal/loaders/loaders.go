@@ -0,0 +1,94 @@
+package loaders
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"ssot/gql/graphql/graph/model"
+	graphservices "ssot/gql/graphql/graph/services"
+	"ssot/gql/graphql/internal/acl"
+	"ssot/gql/graphql/internal/services"
+)
+
+const (
+	// batchWait is how long a batch stays open for more keys after its first one
+	batchWait = 2 * time.Millisecond
+	// maxBatchKeys matches the BatchGetItem key limit, so a batch is one DynamoDB call
+	maxBatchKeys = 100
+)
+
+type contextKey string
+
+const loadersContextKey = contextKey("loaders")
+
+// Loaders holds the per-request loaders for nested relationships. Each is bound to the caller
+// that made the request, and applies that caller's column permissions and field filters.
+type Loaders struct {
+	// LoanInfo loads loans by loan code; loans the caller may not see, or every loan if the
+	// caller cannot read LoanInfo, load as nil
+	LoanInfo *Loader[string, *model.LoanInfo]
+}
+
+// access is the caller's ACL context for one table
+type access struct {
+	columnPermissions *acl.ColumnPermissions
+	fieldFilters      map[string]acl.FieldFilter
+}
+
+// NewLoaders creates the loaders for one request. ctx must carry the authenticated user.
+func NewLoaders(ctx context.Context, serviceManager *services.ServiceManager) *Loaders {
+	loanInfoAccess := sync.OnceValues(func() (access, error) {
+		return resolveAccess(ctx, serviceManager, "LoanInfo", "ssot:gql:loaninfo:read", graphservices.LoanInfoColumns.ACLColumns())
+	})
+
+	return &Loaders{
+		LoanInfo: NewLoader(ctx, batchWait, maxBatchKeys, func(ctx context.Context, loanCodes []string) (map[string]*model.LoanInfo, error) {
+			callerAccess, err := loanInfoAccess()
+			if errors.Is(err, acl.ErrAccessDenied) {
+				return nil, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			return serviceManager.LoanInfoService.GetByLoanCodes(ctx, loanCodes, callerAccess.columnPermissions, callerAccess.fieldFilters)
+		}),
+	}
+}
+
+// resolveAccess reads the caller's column permissions and field filters for a table. An error
+// from either fails every key of the batches that need them.
+func resolveAccess(ctx context.Context, serviceManager *services.ServiceManager, table, requiredScope string, columns []string) (access, error) {
+	columnPermissions, err := serviceManager.ACLMiddleware.GetColumnPermissionsFlexible(ctx, table, requiredScope, columns)
+	if err != nil {
+		return access{}, err
+	}
+
+	// Without the field filters every row would be visible, so the whole batch fails instead
+	fieldFilters, err := serviceManager.ACLMiddleware.GetFieldFilters(ctx)
+	if err != nil {
+		return access{}, err
+	}
+
+	return access{columnPermissions: columnPermissions, fieldFilters: fieldFilters}, nil
+}
+
+// Middleware attaches a fresh set of loaders to every request. It must run after the
+// authentication middleware, since the loaders take the caller from the request context.
+func Middleware(serviceManager *services.ServiceManager, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), loadersContextKey, NewLoaders(r.Context(), serviceManager))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// For returns the loaders of the current request, or a fresh set bound to ctx if the
+// middleware did not run (e.g. in tests calling resolvers directly)
+func For(ctx context.Context, serviceManager *services.ServiceManager) *Loaders {
+	if loaders, ok := ctx.Value(loadersContextKey).(*Loaders); ok {
+		return loaders
+	}
+	return NewLoaders(ctx, serviceManager)
+}
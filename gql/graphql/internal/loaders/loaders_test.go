@@ -0,0 +1,95 @@
+package loaders
+
+import (
+	"context"
+	"testing"
+
+	"ssot/gql/graphql/internal/auth"
+	"ssot/gql/graphql/internal/dynamotest"
+	"ssot/gql/graphql/internal/services"
+)
+
+func TestLoanInfoLoaderAccess(t *testing.T) {
+	loans := map[string]dynamotest.Item{
+		"L1": {"loancode": dynamotest.S("L1"), "loandesc": dynamotest.S("First")},
+		"L2": {"loancode": dynamotest.S("L2"), "loandesc": dynamotest.S("Second")},
+	}
+
+	tests := []struct {
+		name        string
+		aclRecord   dynamotest.Item // nil makes the ACL table unreadable
+		scope       string
+		wantLoaded  int
+		wantErr     bool
+		wantBatches int
+	}{
+		{
+			name:        "readable loans load in one batch",
+			aclRecord:   dynamotest.Item{"PrincipalID": dynamotest.S("user@example.com"), "Permissions": map[string]any{"M": map[string]any{"LoanInfo#*": dynamotest.S("read")}}},
+			wantLoaded:  2,
+			wantBatches: 1,
+		},
+		{
+			name:      "blocked table loads every loan as nil",
+			aclRecord: dynamotest.Item{"PrincipalID": dynamotest.S("user@example.com"), "Permissions": map[string]any{"M": map[string]any{"LoanInfo#*": dynamotest.S("blocking")}}},
+		},
+		{
+			// The scope grants the columns, but the field filters can't be read
+			name:    "unreadable field filters fail the whole batch",
+			scope:   "ssot:gql:loaninfo:read",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := dynamotest.NewServer(t)
+			server.Handle("GetItem", func(input map[string]any) (any, error) {
+				if tt.aclRecord == nil {
+					return nil, &dynamotest.Error{Type: "ProvisionedThroughputExceededException", Message: "slow down"}
+				}
+				return map[string]any{"Item": tt.aclRecord}, nil
+			})
+			server.Handle("BatchGetItem", func(input map[string]any) (any, error) {
+				request := input["RequestItems"].(map[string]any)["loaninfo"].(map[string]any)
+				var found []dynamotest.Item
+				for _, key := range request["Keys"].([]any) {
+					code := key.(map[string]any)["loancode"].(map[string]any)["S"].(string)
+					if item, ok := loans[code]; ok {
+						found = append(found, item)
+					}
+				}
+				return map[string]any{"Responses": map[string]any{"loaninfo": found}}, nil
+			})
+
+			serviceManager := services.NewServiceManager(t.Context(), services.ServiceConfig{
+				DynamoClient:      server.Client(),
+				ACLTableName:      "acl",
+				LoanInfoTableName: "loaninfo",
+			})
+			ctx := context.WithValue(t.Context(), auth.UserContextKey, &auth.User{Email: "user@example.com", Scope: tt.scope})
+
+			loaded, err := NewLoaders(ctx, serviceManager).LoanInfo.LoadMany(ctx, []string{"L1", "L2", "L3"})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("LoadMany succeeded without the caller's field filters")
+				}
+			} else if err != nil {
+				t.Fatalf("LoadMany: %v", err)
+			}
+
+			count := 0
+			for _, loan := range loaded {
+				if loan != nil {
+					count++
+				}
+			}
+			if count != tt.wantLoaded {
+				t.Errorf("loaded %d loans, want %d", count, tt.wantLoaded)
+			}
+			if batches := len(server.Requests("BatchGetItem")); batches != tt.wantBatches {
+				t.Errorf("read loan info in %d batches, want %d", batches, tt.wantBatches)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"os"
+	"strconv"
 	"time"
 
 	"ssot/gql/graphql/graph/services"
@@ -15,24 +16,27 @@ type ServiceManager struct {
 	LoanCashFlowService *services.LoanCashFlowService
 	ACLService          *acl.ACLService
 	ACLMiddleware       *acl.ACLMiddleware
+	LoanInfoService     *services.LoanInfoService
+	PropertyService     *services.PropertyService
 	// Future services can be added here
-	// LoanInfoService     *services.LoanInfoService
-	// PropertyService     *services.PropertyService
 }
 
 type ServiceConfig struct {
 	DynamoClient          *dynamodb.Client
 	LoanCashFlowTableName string
 	ACLTableName          string
+	ACLAuditTableName     string
+	ACLVersionTableName   string
 	ACLCacheTTL           time.Duration
+	LoanCashFlowOptions   services.LoanCashFlowOptions
+	LoanInfoTableName     string
 	// Future table names can be added here
-	// LoanInfoTableName          string
 	// PropertyTableName          string
 }
 
 func NewServiceManager(ctx context.Context, config ServiceConfig) *ServiceManager {
 	// Initialize ACL components
-	aclRepo := acl.NewDynamoRepository(config.DynamoClient, config.ACLTableName)
+	aclRepo := acl.NewDynamoRepository(config.DynamoClient, config.ACLTableName, config.ACLAuditTableName, config.ACLVersionTableName)
 	aclService := acl.NewACLService(ctx, aclRepo, config.ACLCacheTTL)
 	aclMiddleware := acl.NewACLMiddleware(aclService)
 
@@ -40,9 +44,12 @@ func NewServiceManager(ctx context.Context, config ServiceConfig) *ServiceManage
 		LoanCashFlowService: services.NewLoanCashFlowService(
 			config.DynamoClient,
 			config.LoanCashFlowTableName,
+			config.LoanCashFlowOptions,
 		),
-		ACLService:    aclService,
-		ACLMiddleware: aclMiddleware,
+		ACLService:      aclService,
+		ACLMiddleware:   aclMiddleware,
+		LoanInfoService: services.NewLoanInfoService(config.DynamoClient, config.LoanInfoTableName),
+		PropertyService: services.NewPropertyService(config.DynamoClient, config.LoanInfoTableName), // Properties are read from the loan info table
 		// Future service initializations can be added here
 	}
 }
@@ -52,9 +59,17 @@ func LoadServiceConfigFromEnv(dynamoClient *dynamodb.Client) ServiceConfig {
 		DynamoClient:          dynamoClient,
 		LoanCashFlowTableName: getLoanCashFlowTableName(),
 		ACLTableName:          getACLTableName(),
+		ACLAuditTableName:     getACLAuditTableName(),
+		ACLVersionTableName:   getACLVersionTableName(),
 		ACLCacheTTL:           15 * time.Minute, // 15 minute cache TTL
+		LoanCashFlowOptions: services.LoanCashFlowOptions{
+			MaxItemsPerQuery: getEnvIntWithDefault("LOAN_CASHFLOW_MAX_ITEMS_PER_QUERY", 0), // 0 uses the service default
+			Concurrency:      getEnvIntWithDefault("LOAN_CASHFLOW_CONCURRENCY", 0),         // 0 uses the service default
+			PartialResults:   getEnvWithDefault("LOAN_CASHFLOW_PARTIAL_RESULTS", "false") == "true",
+			ScanSegments:     getEnvIntWithDefault("LOAN_CASHFLOW_SCAN_SEGMENTS", 0), // 0 uses the service default
+		},
+		LoanInfoTableName: getEnvWithDefault("LOAN_INFO_TABLE_NAME", "pbi-loaninfo"), // The sync job writes one table for every stage
 		// Future environment variable mappings can be added here
-		// LoanInfoTableName:     getEnvWithDefault("LOAN_INFO_TABLE_NAME", "pbi-loaninfo"),
 		// PropertyTableName:     getEnvWithDefault("PROPERTY_TABLE_NAME", "pbi-property"),
 	}
 }
@@ -83,6 +98,13 @@ func getEnvWithDefault(key, defaultValue string) string {
 	return defaultValue
 }
 
+func getEnvIntWithDefault(key string, defaultValue int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
 func getACLTableName() string {
 	if tableName := os.Getenv("ACL_TABLE_NAME"); tableName != "" {
 		return tableName
@@ -98,3 +120,35 @@ func getACLTableName() string {
 		return "ssot-gql-acl-staging" // Default for development
 	}
 }
+
+func getACLAuditTableName() string {
+	if tableName := os.Getenv("ACL_AUDIT_TABLE_NAME"); tableName != "" {
+		return tableName
+	}
+
+	env := getEnvWithDefault("ENV", "")
+	switch env {
+	case "prod":
+		return "ssot-gql-acl-audit-prod"
+	case "staging":
+		return "ssot-gql-acl-audit-staging"
+	default:
+		return "ssot-gql-acl-audit-staging" // Default for development
+	}
+}
+
+func getACLVersionTableName() string {
+	if tableName := os.Getenv("ACL_VERSION_TABLE_NAME"); tableName != "" {
+		return tableName
+	}
+
+	env := getEnvWithDefault("ENV", "")
+	switch env {
+	case "prod":
+		return "ssot-gql-acl-versions-prod"
+	case "staging":
+		return "ssot-gql-acl-versions-staging"
+	default:
+		return "ssot-gql-acl-versions-staging" // Default for development
+	}
+}
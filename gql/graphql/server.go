@@ -8,6 +8,7 @@ import (
 	"ssot/gql/graphql/graph"
 	"ssot/gql/graphql/internal/auth"
 	"ssot/gql/graphql/internal/auth/middleware"
+	"ssot/gql/graphql/internal/loaders"
 	"ssot/gql/graphql/internal/services"
 
 	"github.com/99designs/gqlgen/graphql/handler"
@@ -47,6 +48,11 @@ func main() {
 	srv.AddTransport(transport.Options{})
 	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.POST{})
+	// Multipart requests carry the files of importACL
+	srv.AddTransport(transport.MultipartForm{
+		MaxUploadSize: 10 << 20,
+		MaxMemory:     10 << 20,
+	})
 
 	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
 
@@ -72,8 +78,8 @@ func main() {
 	// GraphQL playground
 	mux.Handle("/", playground.Handler("GraphQL playground", "/query"))
 
-	// GraphQL endpoint with authentication middleware
-	mux.Handle("/query", middleware.Middleware(srv))
+	// GraphQL endpoint with authentication middleware, then per-request dataloaders for the caller
+	mux.Handle("/query", middleware.Middleware(loaders.Middleware(serviceManager, srv)))
 
 	log.Printf("starting the server at :%s for GraphQL", port)
 	log.Printf("current environment: %s", auth.GetCurrentEnv())